@@ -1,8 +1,15 @@
 package bingx
 
 import (
+	"context"
+	"crypto/tls"
+	"log/slog"
+	nethttp "net/http"
+	"net/url"
 	"sync"
+	"time"
 
+	"github.com/tigusigalpa/bingx-go/v2/environment"
 	"github.com/tigusigalpa/bingx-go/v2/http"
 	"github.com/tigusigalpa/bingx-go/v2/services"
 	"github.com/tigusigalpa/bingx-go/v2/websocket"
@@ -23,29 +30,41 @@ type Client struct {
 	coinMClient  *CoinMClient
 	tradfiClient *TradFiClient
 	lazyMu       sync.Mutex
+	logger       *slog.Logger
+	env          environment.Environment
 }
 
 func NewClient(apiKey, apiSecret string, options ...ClientOption) *Client {
 	config := &ClientConfig{
-		BaseURI:           "https://open-api.bingx.com",
+		BaseURI:           environment.Production.RESTBaseURL,
 		SignatureEncoding: "hex",
+		Environment:       environment.Production,
 	}
 
 	for _, opt := range options {
 		opt(config)
 	}
 
+	client := &Client{logger: config.Logger, env: config.Environment}
+	httpOptions := config.httpOptions()
+
+	var timeSync *http.TimeSync
+	if config.TimeSyncInterval > 0 {
+		timeSync = http.NewTimeSync(func(ctx context.Context) (time.Time, error) {
+			return client.market.FetchServerTime(ctx)
+		}, config.TimeSyncInterval)
+		httpOptions = append(httpOptions, http.WithTimeSync(timeSync))
+	}
+
 	httpClient := http.NewBaseHTTPClient(
 		apiKey,
 		apiSecret,
 		config.BaseURI,
 		config.SourceKey,
 		config.SignatureEncoding,
+		httpOptions...,
 	)
-
-	client := &Client{
-		httpClient: httpClient,
-	}
+	client.httpClient = httpClient
 
 	client.market = services.NewMarketService(httpClient)
 	client.account = services.NewAccountService(httpClient)
@@ -58,6 +77,10 @@ func NewClient(apiKey, apiSecret string, options ...ClientOption) *Client {
 	client.subAccount = services.NewSubAccountService(httpClient)
 	client.copyTrading = services.NewCopyTradingService(httpClient)
 
+	if timeSync != nil {
+		timeSync.Start()
+	}
+
 	return client
 }
 
@@ -69,10 +92,102 @@ func NewDemoClient(apiKey, apiSecret string, options ...ClientOption) *Client {
 	return NewClient(apiKey, apiSecret, demoOptions...)
 }
 
+// NewPublicClient creates a client without credentials for market data.
+// Public endpoints such as Market() are sent unsigned; signed endpoints fail
+// with an *errors.AuthenticationException without reaching the exchange.
+func NewPublicClient(options ...ClientOption) *Client {
+	return NewClient("", "", options...)
+}
+
 type ClientConfig struct {
+	// Environment supplies the WebSocket endpoints of streams created by the
+	// client. WithEnvironment also sets BaseURI from it.
+	Environment       environment.Environment
 	BaseURI           string
 	SourceKey         string
 	SignatureEncoding string
+	// Signer replaces HMAC signing with the API secret when non-nil, e.g.
+	// for Ed25519 or RSA API keys.
+	Signer http.Signer
+	// CredentialsProvider, when non-nil, supplies the API key and secret for
+	// every signed request instead of the NewClient arguments.
+	CredentialsProvider http.CredentialsProvider
+
+	// HTTPClient, when set, is copied and used to send every REST request.
+	HTTPClient *nethttp.Client
+	// Transport replaces the RoundTripper of the HTTP client.
+	Transport nethttp.RoundTripper
+	// Timeout overrides the overall request timeout (default 30s) when non-nil.
+	Timeout *time.Duration
+	// Proxy and TLSConfig are applied to the underlying *http.Transport.
+	Proxy     func(*nethttp.Request) (*url.URL, error)
+	TLSConfig *tls.Config
+
+	// RetryPolicy enables automatic retries of transient failures when non-nil.
+	RetryPolicy *http.RetryPolicy
+	// RateLimiter throttles requests per endpoint group when non-nil.
+	RateLimiter *http.RateLimiter
+	// TimeSyncInterval enables server clock synchronization when positive.
+	TimeSyncInterval time.Duration
+	// RecvWindow is the default recvWindow for signed requests when positive.
+	RecvWindow time.Duration
+	// Interceptors observe every REST call, in order.
+	Interceptors []http.Interceptor
+	// Logger, when set, receives redacted records of REST calls and of the
+	// WebSocket streams created by the client.
+	Logger *slog.Logger
+	// LogLevels overrides http.DefaultLogLevels for REST records when non-nil.
+	LogLevels *http.LogLevels
+	// JSONNumbers decodes numbers in untyped responses as json.Number
+	// instead of float64.
+	JSONNumbers bool
+}
+
+func (c *ClientConfig) httpOptions() []http.Option {
+	var options []http.Option
+	if c.Signer != nil {
+		options = append(options, http.WithSigner(c.Signer))
+	}
+	if c.CredentialsProvider != nil {
+		options = append(options, http.WithCredentialsProvider(c.CredentialsProvider))
+	}
+	if c.HTTPClient != nil {
+		options = append(options, http.WithHTTPClient(c.HTTPClient))
+	}
+	if c.Transport != nil {
+		options = append(options, http.WithTransport(c.Transport))
+	}
+	if c.Timeout != nil {
+		options = append(options, http.WithTimeout(*c.Timeout))
+	}
+	if c.Proxy != nil {
+		options = append(options, http.WithProxy(c.Proxy))
+	}
+	if c.TLSConfig != nil {
+		options = append(options, http.WithTLSConfig(c.TLSConfig))
+	}
+	if c.RetryPolicy != nil {
+		options = append(options, http.WithRetryPolicy(*c.RetryPolicy))
+	}
+	if c.RateLimiter != nil {
+		options = append(options, http.WithRateLimiter(c.RateLimiter))
+	}
+	if c.RecvWindow > 0 {
+		options = append(options, http.WithRecvWindow(c.RecvWindow))
+	}
+	if len(c.Interceptors) > 0 {
+		options = append(options, http.WithInterceptors(c.Interceptors...))
+	}
+	if c.Logger != nil {
+		options = append(options, http.WithLogger(c.Logger))
+	}
+	if c.LogLevels != nil {
+		options = append(options, http.WithLogLevels(*c.LogLevels))
+	}
+	if c.JSONNumbers {
+		options = append(options, http.WithJSONNumbers())
+	}
+	return options
 }
 
 type ClientOption func(*ClientConfig)
@@ -98,13 +213,142 @@ func WithSignatureEncoding(encoding string) ClientOption {
 	}
 }
 
-// WithDemoEnvironment configures the client for demo trading (VST environment)
-func WithDemoEnvironment() ClientOption {
+// WithSigner signs requests with signer instead of HMAC-SHA256 over the API
+// secret. See http.NewEd25519Signer, http.NewRSASigner and http.SignerFunc.
+func WithSigner(signer http.Signer) ClientOption {
+	return func(c *ClientConfig) {
+		c.Signer = signer
+	}
+}
+
+// WithCredentialsProvider fetches the API key and secret from provider for
+// every signed request, so keys can be rotated without rebuilding the client.
+// CoinM() and TradFi() share the provider. Wrap slow providers with
+// http.NewCachedCredentials.
+func WithCredentialsProvider(provider http.CredentialsProvider) ClientOption {
+	return func(c *ClientConfig) {
+		c.CredentialsProvider = provider
+	}
+}
+
+// WithHTTPClient sends REST requests through a copy of hc, e.g. one with
+// custom connection pooling limits or the client of an httptest.Server.
+func WithHTTPClient(hc *nethttp.Client) ClientOption {
+	return func(c *ClientConfig) {
+		c.HTTPClient = hc
+	}
+}
+
+// WithTransport replaces the RoundTripper used for REST requests.
+func WithTransport(rt nethttp.RoundTripper) ClientOption {
+	return func(c *ClientConfig) {
+		c.Transport = rt
+	}
+}
+
+// WithTimeout sets the overall REST request timeout. Zero disables it.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *ClientConfig) {
+		c.Timeout = &timeout
+	}
+}
+
+// WithProxy routes REST requests through the given proxy function,
+// e.g. WithProxy(http.ProxyURL(u)).
+func WithProxy(proxy func(*nethttp.Request) (*url.URL, error)) ClientOption {
+	return func(c *ClientConfig) {
+		c.Proxy = proxy
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used for REST requests.
+func WithTLSConfig(cfg *tls.Config) ClientOption {
+	return func(c *ClientConfig) {
+		c.TLSConfig = cfg
+	}
+}
+
+// WithRetryPolicy retries transient failures (network errors, 5xx, 429 and
+// BingX rate-limit codes) with exponential backoff. GET requests are always
+// eligible; POST requests only when policy.RetryPOSTWithClientOrderID is set
+// and a clientOrderId is present. See http.DefaultRetryPolicy.
+func WithRetryPolicy(policy http.RetryPolicy) ClientOption {
+	return func(c *ClientConfig) {
+		c.RetryPolicy = &policy
+	}
+}
+
+// WithRateLimiter throttles REST requests client-side, e.g.
+// WithRateLimiter(http.NewRateLimiter(http.RateLimitBlock, http.DefaultRateLimitRules()...)).
+func WithRateLimiter(limiter *http.RateLimiter) ClientOption {
+	return func(c *ClientConfig) {
+		c.RateLimiter = limiter
+	}
+}
+
+// WithTimeSync periodically samples the futures server time and corrects
+// every generated request timestamp by the smoothed clock offset. Call
+// Client.Close to stop the background synchronization.
+func WithTimeSync(interval time.Duration) ClientOption {
 	return func(c *ClientConfig) {
-		c.BaseURI = "https://open-api-vst.bingx.com"
+		c.TimeSyncInterval = interval
 	}
 }
 
+// WithRecvWindow sets the default recvWindow sent with every signed request.
+// Override it per call with http.ContextWithRecvWindow or an explicit
+// recvWindow argument.
+func WithRecvWindow(window time.Duration) ClientOption {
+	return func(c *ClientConfig) {
+		c.RecvWindow = window
+	}
+}
+
+// WithInterceptors adds request/response hooks for logging, metrics,
+// tracing or auditing. Parameters passed to the hooks have secrets redacted.
+func WithInterceptors(interceptors ...http.Interceptor) ClientOption {
+	return func(c *ClientConfig) {
+		c.Interceptors = append(c.Interceptors, interceptors...)
+	}
+}
+
+// WithLogger logs REST calls, WebSocket sessions and dropped messages to
+// logger. The API key, secret, signature and listenKey are always redacted.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *ClientConfig) {
+		c.Logger = logger
+	}
+}
+
+// WithLogLevels sets the levels used for REST records.
+func WithLogLevels(levels http.LogLevels) ClientOption {
+	return func(c *ClientConfig) {
+		c.LogLevels = &levels
+	}
+}
+
+// WithJSONNumbers decodes numbers in map responses as json.Number instead of
+// float64, which cannot represent 19-digit order IDs exactly.
+func WithJSONNumbers() ClientOption {
+	return func(c *ClientConfig) {
+		c.JSONNumbers = true
+	}
+}
+
+// WithEnvironment points the REST client and the streams created by the
+// client at env. A later WithBaseURI still overrides the REST URL.
+func WithEnvironment(env environment.Environment) ClientOption {
+	return func(c *ClientConfig) {
+		c.Environment = env
+		c.BaseURI = env.RESTBaseURL
+	}
+}
+
+// WithDemoEnvironment configures the client for demo trading (VST environment)
+func WithDemoEnvironment() ClientOption {
+	return WithEnvironment(environment.Demo)
+}
+
 func (c *Client) Market() *services.MarketService {
 	return c.market
 }
@@ -164,6 +408,20 @@ func (c *Client) TradFi() *TradFiClient {
 	return c.tradfiClient
 }
 
+// TimeSync returns the clock synchronizer enabled by WithTimeSync, or nil.
+// Its Status reports the current drift.
+func (c *Client) TimeSync() *http.TimeSync {
+	return c.httpClient.TimeSync()
+}
+
+// Close stops background work started by the client, such as time sync.
+func (c *Client) Close() error {
+	if ts := c.httpClient.TimeSync(); ts != nil {
+		ts.Stop()
+	}
+	return nil
+}
+
 func (c *Client) GetHTTPClient() *http.BaseHTTPClient {
 	return c.httpClient
 }
@@ -176,22 +434,79 @@ func (c *Client) GetAPIKey() string {
 	return c.httpClient.GetAPIKey()
 }
 
+// SyncRateLimits seeds the configured rate limiter from
+// Account().GetAPIRateLimits and returns the number of rules applied. It is a
+// no-op when no limiter is configured.
+func (c *Client) SyncRateLimits(ctx context.Context) (int, error) {
+	limiter := c.httpClient.RateLimiter()
+	if limiter == nil {
+		return 0, nil
+	}
+
+	resp, err := c.account.GetAPIRateLimitsContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return limiter.SeedFromAPIRateLimits(resp)
+}
+
 func (c *Client) GetBalance() (map[string]interface{}, error) {
 	return c.account.GetBalance()
 }
 
+func (c *Client) GetBalanceContext(ctx context.Context) (map[string]interface{}, error) {
+	return c.account.GetBalanceContext(ctx)
+}
+
 func (c *Client) GetSymbols() (map[string]interface{}, error) {
 	return c.market.GetFuturesSymbols()
 }
 
+func (c *Client) GetSymbolsContext(ctx context.Context) (map[string]interface{}, error) {
+	return c.market.GetFuturesSymbolsContext(ctx)
+}
+
 func (c *Client) CreateOrder(params map[string]interface{}) (map[string]interface{}, error) {
 	return c.trade.CreateOrder(params)
 }
 
-func (c *Client) NewMarketDataStream() *websocket.MarketDataStream {
-	return websocket.NewMarketDataStream()
+func (c *Client) CreateOrderContext(ctx context.Context, params map[string]interface{}) (map[string]interface{}, error) {
+	return c.trade.CreateOrderContext(ctx, params)
+}
+
+// Environment returns the environment the client's streams connect to.
+func (c *Client) Environment() environment.Environment {
+	return c.env
 }
 
-func (c *Client) NewAccountDataStream(listenKey string) *websocket.AccountDataStream {
-	return websocket.NewAccountDataStream(listenKey)
+// NewMarketDataStream creates a market data stream in the client's
+// environment. Pass websocket.WithMarket for spot or coin-M streams.
+func (c *Client) NewMarketDataStream(opts ...websocket.StreamOption) *websocket.MarketDataStream {
+	stream := websocket.NewMarketDataStream(append([]websocket.StreamOption{websocket.WithEnvironment(c.env)}, opts...)...)
+	if c.logger != nil {
+		stream.AddLogger(c.logger, websocket.DefaultLogLevels())
+	}
+	return stream
+}
+
+// NewAccountDataStream creates an account data stream for listenKey in the
+// client's environment. Pass websocket.WithMarket for spot or coin-M streams.
+func (c *Client) NewAccountDataStream(listenKey string, opts ...websocket.StreamOption) *websocket.AccountDataStream {
+	stream := websocket.NewAccountDataStream(listenKey, append([]websocket.StreamOption{websocket.WithEnvironment(c.env)}, opts...)...)
+	if c.logger != nil {
+		stream.AddLogger(c.logger, websocket.DefaultLogLevels())
+	}
+	return stream
+}
+
+// NewManagedAccountStream creates a USDT-M account data stream in the
+// client's environment that creates, extends, renews and deletes its own
+// listen key. For coin-M or TradFi accounts use
+// websocket.NewManagedAccountStream with CoinMListenKeys or TradFiListenKeys.
+func (c *Client) NewManagedAccountStream(opts ...websocket.StreamOption) *websocket.ManagedAccountStream {
+	stream := websocket.NewManagedAccountStream(USDTMListenKeys(c.ListenKey()), append([]websocket.StreamOption{websocket.WithEnvironment(c.env)}, opts...)...)
+	if c.logger != nil {
+		stream.AddLogger(c.logger, websocket.DefaultLogLevels())
+	}
+	return stream
 }
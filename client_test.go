@@ -1,15 +1,26 @@
 package bingx
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/hex"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/tigusigalpa/bingx-go/v2/environment"
+	bxhttp "github.com/tigusigalpa/bingx-go/v2/http"
 )
 
 func TestNewClient(t *testing.T) {
@@ -132,6 +143,14 @@ func TestClientWebSocketStreams(t *testing.T) {
 	if accountStream == nil {
 		t.Error("Account data stream should not be nil")
 	}
+
+	managedStream := client.NewManagedAccountStream()
+	if managedStream == nil {
+		t.Error("Managed account stream should not be nil")
+	}
+	if managedStream.ListenKey() != "" {
+		t.Error("Managed account stream should not have a listen key before Run")
+	}
 }
 
 func TestClientOptions(t *testing.T) {
@@ -165,6 +184,70 @@ func TestClientOptions(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name:   "WithTimeout",
+			option: WithTimeout(5 * time.Second),
+			checkFn: func(c *ClientConfig) bool {
+				return c.Timeout != nil && *c.Timeout == 5*time.Second
+			},
+			expected: true,
+		},
+		{
+			name:   "WithHTTPClient",
+			option: WithHTTPClient(http.DefaultClient),
+			checkFn: func(c *ClientConfig) bool {
+				return c.HTTPClient == http.DefaultClient
+			},
+			expected: true,
+		},
+		{
+			name:   "WithRetryPolicy",
+			option: WithRetryPolicy(bxhttp.DefaultRetryPolicy()),
+			checkFn: func(c *ClientConfig) bool {
+				return c.RetryPolicy != nil && c.RetryPolicy.MaxAttempts == 3
+			},
+			expected: true,
+		},
+		{
+			name:   "WithJSONNumbers",
+			option: WithJSONNumbers(),
+			checkFn: func(c *ClientConfig) bool {
+				return c.JSONNumbers
+			},
+			expected: true,
+		},
+		{
+			name:   "WithRecvWindow",
+			option: WithRecvWindow(2 * time.Second),
+			checkFn: func(c *ClientConfig) bool {
+				return c.RecvWindow == 2*time.Second
+			},
+			expected: true,
+		},
+		{
+			name:   "WithSigner",
+			option: WithSigner(bxhttp.NewHMACSigner("secret", "hex")),
+			checkFn: func(c *ClientConfig) bool {
+				return c.Signer != nil
+			},
+			expected: true,
+		},
+		{
+			name:   "WithLogger",
+			option: WithLogger(slog.Default()),
+			checkFn: func(c *ClientConfig) bool {
+				return c.Logger == slog.Default()
+			},
+			expected: true,
+		},
+		{
+			name:   "WithTransport",
+			option: WithTransport(http.DefaultTransport),
+			checkFn: func(c *ClientConfig) bool {
+				return c.Transport == http.DefaultTransport
+			},
+			expected: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -386,3 +469,177 @@ func hasSignature(body string) bool {
 	sig := values.Get("signature")
 	return len(sig) == 64
 }
+
+func TestWithHTTPClient_UsesInjectedClient(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"code":0,"data":{}}`)
+	}))
+	defer srv.Close()
+
+	// The default client does not trust the test server's certificate, so the
+	// call only succeeds when the injected client is actually used.
+	client := NewClient("test-key", testClientSecret, WithBaseURI(srv.URL), WithHTTPClient(srv.Client()))
+	if _, err := client.Account().GetBalance(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWithTLSConfig_TrustsCustomRoots(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"code":0,"data":{}}`)
+	}))
+	defer srv.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(srv.Certificate())
+
+	client := NewClient("test-key", testClientSecret, WithBaseURI(srv.URL), WithTLSConfig(&tls.Config{RootCAs: roots}))
+	if _, err := client.Market().GetFuturesSymbols(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	untrusted := NewClient("test-key", testClientSecret, WithBaseURI(srv.URL))
+	if _, err := untrusted.Market().GetFuturesSymbols(); err == nil {
+		t.Fatal("expected a certificate error without the custom TLS config")
+	}
+}
+
+func TestSyncRateLimits_SeedsConfiguredLimiter(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/openApi/swap/v2/user/apiRateLimits" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprint(w, `{"code":0,"data":[{"path":"/openApi/swap/v2/trade","limit":5,"interval":1000}]}`)
+	}))
+	defer srv.Close()
+
+	limiter := bxhttp.NewRateLimiter(bxhttp.RateLimitBlock)
+	client := NewClient("test-key", testClientSecret, WithBaseURI(srv.URL), WithRateLimiter(limiter))
+
+	applied, err := client.SyncRateLimits(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if applied != 1 || len(limiter.Rules()) != 1 {
+		t.Errorf("expected one seeded rule, got %d (%v)", applied, limiter.Rules())
+	}
+
+	if n, err := NewClient("k", "s", WithBaseURI(srv.URL)).SyncRateLimits(context.Background()); n != 0 || err != nil {
+		t.Errorf("expected no-op without a limiter, got %d, %v", n, err)
+	}
+}
+
+func TestWithTimeSync_CorrectsRequestTimestamps(t *testing.T) {
+	timestamps := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/openApi/swap/v2/market/time" {
+			_, _ = fmt.Fprintf(w, `{"code":0,"data":{"serverTime":%d}}`, time.Now().Add(time.Hour).UnixMilli())
+			return
+		}
+		timestamps <- r.URL.Query().Get("timestamp")
+		_, _ = fmt.Fprint(w, `{"code":0,"data":{}}`)
+	}))
+	defer srv.Close()
+
+	client := NewClient("test-key", testClientSecret, WithBaseURI(srv.URL), WithTimeSync(time.Minute))
+	defer func() { _ = client.Close() }()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for client.TimeSync().Status().Samples == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("time sync never completed")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if _, err := client.Account().GetBalance(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ms, err := strconv.ParseInt(<-timestamps, 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if drift := time.UnixMilli(ms).Sub(time.Now()); drift < 59*time.Minute || drift > 61*time.Minute {
+		t.Errorf("expected the request timestamp to be shifted by about one hour, got %s", drift)
+	}
+}
+
+func TestWithCredentialsProvider_SharedWithLazyClients(t *testing.T) {
+	var mu sync.Mutex
+	var keys []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys = append(keys, r.Header.Get("X-BX-APIKEY"))
+		mu.Unlock()
+		_, _ = fmt.Fprint(w, `{"code":0,"data":{}}`)
+	}))
+	defer srv.Close()
+
+	var current atomic.Value
+	current.Store("key-a")
+	provider := bxhttp.CredentialsProviderFunc(func(context.Context) (bxhttp.Credentials, error) {
+		return bxhttp.Credentials{APIKey: current.Load().(string), APISecret: testClientSecret}, nil
+	})
+	client := NewClient("", "", WithBaseURI(srv.URL), WithCredentialsProvider(provider))
+
+	if _, err := client.GetBalance(); err != nil {
+		t.Fatal(err)
+	}
+	coinM := client.CoinM()
+	current.Store("key-b")
+	if _, err := coinM.ListenKey().Generate(); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(keys) != 2 || keys[0] != "key-a" || keys[1] != "key-b" {
+		t.Errorf("expected the rotated key on the CoinM client, got %v", keys)
+	}
+}
+
+func TestNewPublicClient_MarketDataWithoutCredentials(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		if r.Header.Get("X-BX-APIKEY") != "" || r.URL.Query().Get("signature") != "" {
+			t.Errorf("public request was signed: %s", r.URL.RawQuery)
+		}
+		_, _ = fmt.Fprint(w, `{"code":0,"data":{}}`)
+	}))
+	defer srv.Close()
+
+	client := NewPublicClient(WithBaseURI(srv.URL))
+	if _, err := client.Market().GetKlines("BTC-USDT", "1m", 10, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.GetBalance(); err == nil {
+		t.Error("signed endpoints should fail without credentials")
+	}
+	if n := atomic.LoadInt32(&hits); n != 1 {
+		t.Errorf("expected only the public request to reach the server, got %d", n)
+	}
+}
+
+func TestWithEnvironment_AppliesToRESTAndStreams(t *testing.T) {
+	demo := NewDemoClient("key", "secret")
+	if demo.GetEndpoint() != environment.Demo.RESTBaseURL || demo.Environment().Name != "demo" {
+		t.Errorf("demo client should use the VST environment, got %s (%s)", demo.GetEndpoint(), demo.Environment().Name)
+	}
+
+	mock := environment.Custom("mock", "http://127.0.0.1:8080", "ws://127.0.0.1:8081/ws")
+	client := NewClient("key", "secret", WithEnvironment(mock))
+	if client.GetEndpoint() != "http://127.0.0.1:8080" || client.Environment() != mock {
+		t.Errorf("unexpected environment %s (%+v)", client.GetEndpoint(), client.Environment())
+	}
+
+	override := NewClient("key", "secret", WithEnvironment(mock), WithBaseURI("http://other"))
+	if override.GetEndpoint() != "http://other" || override.Environment() != mock {
+		t.Error("WithBaseURI should override only the REST URL")
+	}
+
+	if NewClient("key", "secret").Environment() != environment.Production {
+		t.Error("the default environment should be production")
+	}
+}
@@ -0,0 +1,136 @@
+// Package decimal provides Decimal, the exact decimal number type used for
+// BingX prices and quantities. It has no dependencies outside the standard
+// library, so both the REST services and the WebSocket streams can use it.
+package decimal
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var pattern = regexp.MustCompile(`^-?(?:0|[1-9]\d*)(?:\.\d+)?(?:[eE][+-]?\d+)?$`)
+
+// maxExponent bounds the exponent Parse expands, so that a value such as
+// "1e999999999" cannot turn into a gigabyte of digits.
+const maxExponent = 1000
+
+// Decimal is an exact decimal number kept in the textual form BingX sent or
+// the caller supplied, so prices and quantities round-trip without float64
+// rounding. It decodes from JSON strings and numbers, and is sent as-is in
+// request parameters. Convert it with Rat, Float64, or an arbitrary-precision
+// library, e.g. shopspring's decimal.RequireFromString(d.String()).
+type Decimal string
+
+// Parse validates s as a decimal number. Exponent forms such as "1.5e3" are
+// rewritten in plain fixed-point form ("1500"), which BingX expects. The
+// empty string is the zero Decimal, which BingX sends for values that are not
+// set yet, such as the average price of an unfilled order.
+func Parse(s string) (Decimal, error) {
+	if s == "" {
+		return "", nil
+	}
+	if !pattern.MatchString(s) {
+		return "", fmt.Errorf("invalid decimal %q", s)
+	}
+
+	mantissa, exponent, found := strings.Cut(strings.ToLower(s), "e")
+	if !found {
+		return Decimal(s), nil
+	}
+	exp, err := strconv.Atoi(exponent)
+	if err != nil || exp < -maxExponent || exp > maxExponent {
+		return "", fmt.Errorf("decimal exponent out of range in %q", s)
+	}
+	places := 0
+	if _, fraction, ok := strings.Cut(mantissa, "."); ok {
+		places = len(fraction)
+	}
+	places -= exp
+	if places < 0 {
+		places = 0
+	}
+	r, _ := new(big.Rat).SetString(s)
+	return Decimal(r.FloatString(places)), nil
+}
+
+// FromFloat returns the shortest decimal that parses back to f.
+func FromFloat(f float64) Decimal {
+	return Decimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+func (d Decimal) String() string {
+	return string(d)
+}
+
+// IsZero reports whether d is empty or numerically zero.
+func (d Decimal) IsZero() bool {
+	r, ok := d.Rat()
+	return !ok || r.Sign() == 0
+}
+
+// Rat returns the exact value of d. ok is false when d is not a decimal.
+func (d Decimal) Rat() (r *big.Rat, ok bool) {
+	if !pattern.MatchString(string(d)) {
+		return nil, false
+	}
+	return new(big.Rat).SetString(string(d))
+}
+
+// Float64 returns the nearest float64 to d.
+func (d Decimal) Float64() (float64, error) {
+	return strconv.ParseFloat(string(d), 64)
+}
+
+// MarshalText implements encoding.TextMarshaler, which also makes Decimal
+// encode as a JSON string and serialize in request parameters. It produces
+// the plain fixed-point form Parse returns, and fails for text that is not a
+// decimal. The zero Decimal is left out of request parameters.
+func (d Decimal) MarshalText() ([]byte, error) {
+	value, err := Parse(string(d))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(value), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	value, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*d = value
+	return nil
+}
+
+// UnmarshalJSON accepts both JSON numbers and decimal strings. null and ""
+// decode as the zero Decimal.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = ""
+		return nil
+	}
+	value, err := decode(data)
+	if err != nil {
+		return err
+	}
+	*d = value
+	return nil
+}
+
+func decode(raw json.RawMessage) (Decimal, error) {
+	var stringValue string
+	if err := json.Unmarshal(raw, &stringValue); err == nil {
+		return Parse(stringValue)
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(raw, &number); err != nil {
+		return "", fmt.Errorf("decimal must be a JSON number or string")
+	}
+	return Parse(number.String())
+}
@@ -0,0 +1,100 @@
+package decimal
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+func TestDecimal_UnmarshalJSON(t *testing.T) {
+	var order struct {
+		Price    Decimal `json:"price"`
+		Quantity Decimal `json:"quantity"`
+		Stop     Decimal `json:"stopPrice"`
+	}
+	body := `{"price":"0.30000000000000000001","quantity":12345678901234567890.5,"stopPrice":null}`
+	if err := json.Unmarshal([]byte(body), &order); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if order.Price != "0.30000000000000000001" || order.Quantity != "12345678901234567890.5" || order.Stop != "" {
+		t.Errorf("unexpected decimals: %+v", order)
+	}
+
+	encoded, err := json.Marshal(order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != `{"price":"0.30000000000000000001","quantity":"12345678901234567890.5","stopPrice":""}` {
+		t.Errorf("unexpected encoding %s", encoded)
+	}
+
+	for _, empty := range []string{`""`, `null`} {
+		d := Decimal("1")
+		if err := json.Unmarshal([]byte(empty), &d); err != nil || d != "" {
+			t.Errorf("Unmarshal(%s) = %q, %v, want the zero Decimal", empty, d, err)
+		}
+	}
+
+	for _, bad := range []string{`"abc"`, `"1.2.3"`, `true`, `{}`} {
+		var d Decimal
+		if err := json.Unmarshal([]byte(bad), &d); err == nil {
+			t.Errorf("expected %s to be rejected, got %q", bad, d)
+		}
+	}
+}
+
+func TestDecimal_Conversions(t *testing.T) {
+	d, err := Parse("0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, ok := d.Rat()
+	if !ok || r.Cmp(big.NewRat(1, 10)) != 0 {
+		t.Errorf("expected exact 1/10, got %v", r)
+	}
+	if f, err := d.Float64(); err != nil || f != 0.1 {
+		t.Errorf("Float64() = %v, %v", f, err)
+	}
+	if FromFloat(0.1) != "0.1" || FromFloat(1e21) != "1000000000000000000000" {
+		t.Error("FromFloat must return the shortest plain decimal")
+	}
+	if !Decimal("0.000").IsZero() || Decimal("0.001").IsZero() {
+		t.Error("unexpected IsZero result")
+	}
+	if d, err := Parse(""); err != nil || d != "" || !d.IsZero() {
+		t.Errorf(`Parse("") = %q, %v, want the zero Decimal`, d, err)
+	}
+	if _, err := Parse("1,5"); err == nil {
+		t.Error("expected invalid decimal error")
+	}
+}
+
+func TestDecimal_NormalizesExponents(t *testing.T) {
+	for in, want := range map[string]Decimal{
+		"1e5":      "100000",
+		"1.5E3":    "1500",
+		"-2.50e1":  "-25.0",
+		"1.5e-3":   "0.0015",
+		"12.34e+0": "12.34",
+		"0e10":     "0",
+	} {
+		if got, err := Parse(in); err != nil || got != want {
+			t.Errorf("Parse(%q) = %q, %v, want %q", in, got, err, want)
+		}
+		if text, err := Decimal(in).MarshalText(); err != nil || Decimal(text) != want {
+			t.Errorf("Decimal(%q).MarshalText() = %q, %v, want %q", in, text, err, want)
+		}
+	}
+
+	var d Decimal
+	if err := json.Unmarshal([]byte(`6.4e4`), &d); err != nil || d != "64000" {
+		t.Errorf("Unmarshal(6.4e4) = %q, %v, want 64000", d, err)
+	}
+
+	if _, err := Parse("1e999999"); err == nil {
+		t.Error("expected an out-of-range exponent to be rejected")
+	}
+	if _, err := Decimal("abc").MarshalText(); err == nil {
+		t.Error("expected MarshalText to reject an invalid decimal")
+	}
+}
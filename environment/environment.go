@@ -0,0 +1,118 @@
+// Package environment describes the BingX deployments a client can talk to.
+// An Environment bundles the REST base URL with the WebSocket endpoints so
+// that bingx.Client and the websocket streams always point at the same place.
+package environment
+
+import "fmt"
+
+// Market selects one of the BingX product lines.
+type Market int
+
+const (
+	// Swap is USDT-margined perpetual futures.
+	Swap Market = iota
+	// Spot is spot trading.
+	Spot
+	// CoinM is coin-margined perpetual futures.
+	CoinM
+)
+
+func (m Market) String() string {
+	switch m {
+	case Swap:
+		return "swap"
+	case Spot:
+		return "spot"
+	case CoinM:
+		return "coin-M"
+	default:
+		return fmt.Sprintf("Market(%d)", int(m))
+	}
+}
+
+// Environment holds the endpoints of one BingX deployment.
+type Environment struct {
+	Name        string
+	RESTBaseURL string
+
+	SwapMarketStreamURL  string
+	SpotMarketStreamURL  string
+	CoinMMarketStreamURL string
+
+	// Account stream URLs receive the listenKey as a query parameter. An
+	// empty URL means the environment has no account stream for the market.
+	SwapAccountStreamURL  string
+	SpotAccountStreamURL  string
+	CoinMAccountStreamURL string
+}
+
+// Production is the live BingX exchange.
+var Production = Environment{
+	Name:                  "production",
+	RESTBaseURL:           "https://open-api.bingx.com",
+	SwapMarketStreamURL:   "wss://open-api-swap.bingx.com/swap-market",
+	SpotMarketStreamURL:   "wss://open-api-ws.bingx.com/market",
+	CoinMMarketStreamURL:  "wss://open-api-cswap-ws.bingx.com/market",
+	SwapAccountStreamURL:  "wss://open-api-swap.bingx.com/swap-market",
+	SpotAccountStreamURL:  "wss://open-api-ws.bingx.com/market",
+	CoinMAccountStreamURL: "wss://open-api-cswap-ws.bingx.com/market",
+}
+
+// Demo is the VST (virtual simulation trading) environment. VST only covers
+// USDT-margined perpetual futures, so the spot and coin-M market streams point
+// at production market data, which is read-only, and there are no spot or
+// coin-M account streams.
+var Demo = Environment{
+	Name:                 "demo",
+	RESTBaseURL:          "https://open-api-vst.bingx.com",
+	SwapMarketStreamURL:  "wss://vst-open-api-ws.bingx.com/swap-market",
+	SpotMarketStreamURL:  Production.SpotMarketStreamURL,
+	CoinMMarketStreamURL: Production.CoinMMarketStreamURL,
+	SwapAccountStreamURL: "wss://vst-open-api-ws.bingx.com/swap-market",
+}
+
+// Custom returns an environment that sends REST calls to restBaseURL and
+// opens every WebSocket stream at streamURL, e.g. for a mock server.
+func Custom(name, restBaseURL, streamURL string) Environment {
+	return Environment{
+		Name:                  name,
+		RESTBaseURL:           restBaseURL,
+		SwapMarketStreamURL:   streamURL,
+		SpotMarketStreamURL:   streamURL,
+		CoinMMarketStreamURL:  streamURL,
+		SwapAccountStreamURL:  streamURL,
+		SpotAccountStreamURL:  streamURL,
+		CoinMAccountStreamURL: streamURL,
+	}
+}
+
+// MarketStreamURL returns the market data stream endpoint of market.
+func (e Environment) MarketStreamURL(market Market) string {
+	switch market {
+	case Spot:
+		return e.SpotMarketStreamURL
+	case CoinM:
+		return e.CoinMMarketStreamURL
+	default:
+		return e.SwapMarketStreamURL
+	}
+}
+
+// AccountStreamURL returns the account data stream endpoint of market,
+// without the listenKey. It fails when the environment has no account stream
+// for market, such as the spot market of Demo.
+func (e Environment) AccountStreamURL(market Market) (string, error) {
+	var url string
+	switch market {
+	case Spot:
+		url = e.SpotAccountStreamURL
+	case CoinM:
+		url = e.CoinMAccountStreamURL
+	default:
+		url = e.SwapAccountStreamURL
+	}
+	if url == "" {
+		return "", fmt.Errorf("environment %s has no %s account stream", e.Name, market)
+	}
+	return url, nil
+}
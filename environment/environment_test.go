@@ -0,0 +1,50 @@
+package environment
+
+import "testing"
+
+func TestEnvironment_StreamURLs(t *testing.T) {
+	tests := []struct {
+		market    Market
+		marketURL string
+		account   string
+	}{
+		{Swap, Production.SwapMarketStreamURL, Production.SwapAccountStreamURL},
+		{Spot, Production.SpotMarketStreamURL, Production.SpotAccountStreamURL},
+		{CoinM, Production.CoinMMarketStreamURL, Production.CoinMAccountStreamURL},
+	}
+
+	for _, tt := range tests {
+		if got := Production.MarketStreamURL(tt.market); got != tt.marketURL {
+			t.Errorf("MarketStreamURL(%d) = %s, want %s", tt.market, got, tt.marketURL)
+		}
+		if got, err := Production.AccountStreamURL(tt.market); err != nil || got != tt.account {
+			t.Errorf("AccountStreamURL(%d) = %s, %v, want %s", tt.market, got, err, tt.account)
+		}
+	}
+}
+
+func TestDemo_UsesVSTForSwap(t *testing.T) {
+	if Demo.RESTBaseURL != "https://open-api-vst.bingx.com" {
+		t.Errorf("unexpected demo REST URL %s", Demo.RESTBaseURL)
+	}
+	if Demo.SwapMarketStreamURL == Production.SwapMarketStreamURL || Demo.SwapAccountStreamURL == Production.SwapAccountStreamURL {
+		t.Error("demo swap streams must not point at production")
+	}
+	for _, market := range []Market{Spot, CoinM} {
+		if url, err := Demo.AccountStreamURL(market); err == nil {
+			t.Errorf("AccountStreamURL(%s) = %s, want an error, VST has no %s account stream", market, url, market)
+		}
+	}
+}
+
+func TestCustom_UsesOneStreamURL(t *testing.T) {
+	env := Custom("mock", "http://127.0.0.1:8080", "ws://127.0.0.1:8081/ws")
+	for _, market := range []Market{Swap, Spot, CoinM} {
+		if url, _ := env.AccountStreamURL(market); env.MarketStreamURL(market) != "ws://127.0.0.1:8081/ws" || url != "ws://127.0.0.1:8081/ws" {
+			t.Errorf("market %d does not use the custom stream URL", market)
+		}
+	}
+	if env.Name != "mock" || env.RESTBaseURL != "http://127.0.0.1:8080" {
+		t.Errorf("unexpected environment %+v", env)
+	}
+}
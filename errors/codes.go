@@ -0,0 +1,126 @@
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+)
+
+// Sentinel errors for the common classes of BingX failures. Returned errors
+// match them with errors.Is:
+//
+//	if errors.Is(err, bxerrors.ErrOrderNotFound) { ... }
+var (
+	ErrAuthentication       = stderrors.New("bingx: authentication failed")
+	ErrIPNotWhitelisted     = stderrors.New("bingx: IP not whitelisted")
+	ErrTimestampOutOfWindow = stderrors.New("bingx: timestamp outside recvWindow")
+	ErrRateLimited          = stderrors.New("bingx: rate limited")
+	ErrInsufficientBalance  = stderrors.New("bingx: insufficient balance")
+	ErrInsufficientMargin   = stderrors.New("bingx: insufficient margin")
+	ErrInvalidSymbol        = stderrors.New("bingx: invalid symbol")
+	ErrInvalidParameter     = stderrors.New("bingx: invalid parameter")
+	ErrOrderNotFound        = stderrors.New("bingx: order not found")
+	ErrFilterViolation      = stderrors.New("bingx: price or quantity filter violated")
+	ErrReduceOnlyRejected   = stderrors.New("bingx: reduce-only order rejected")
+	ErrPositionModeMismatch = stderrors.New("bingx: position mode mismatch")
+	ErrServerBusy           = stderrors.New("bingx: server busy")
+)
+
+var (
+	codeMu      sync.RWMutex
+	codeClasses = map[string]error{
+		"100001": ErrAuthentication, // signature verification failed
+		"100002": ErrAuthentication,
+		"100003": ErrAuthentication,
+		"100004": ErrAuthentication,
+		"100412": ErrAuthentication, // null signature
+		"100413": ErrAuthentication, // incorrect apiKey
+		"100419": ErrIPNotWhitelisted,
+		"100421": ErrTimestampOutOfWindow,
+		"109201": ErrTimestampOutOfWindow,
+
+		"100005": ErrRateLimited,
+		"100410": ErrRateLimited,
+		"100429": ErrRateLimited,
+
+		"200001": ErrInsufficientBalance,
+		"200002": ErrInsufficientBalance,
+		"100202": ErrInsufficientBalance,
+		"101204": ErrInsufficientMargin,
+		"80020":  ErrInsufficientMargin,
+
+		"80014":  ErrInvalidParameter,
+		"100400": ErrInvalidParameter,
+		"109400": ErrInvalidSymbol,
+		"101415": ErrInvalidSymbol,
+		"80016":  ErrOrderNotFound,
+		"80018":  ErrOrderNotFound,
+		"100440": ErrFilterViolation, // price deviates from the mark price
+		"101211": ErrFilterViolation,
+		"101460": ErrFilterViolation,
+		"101485": ErrFilterViolation, // quantity below the minimum
+		"101290": ErrReduceOnlyRejected,
+		"101414": ErrPositionModeMismatch,
+		"109420": ErrPositionModeMismatch,
+
+		"80001":  ErrServerBusy,
+		"80012":  ErrServerBusy,
+		"100500": ErrServerBusy,
+		"100503": ErrServerBusy,
+	}
+)
+
+// ClassifyCode returns the sentinel error for a BingX business code, or nil
+// when the code is unknown.
+func ClassifyCode(code string) error {
+	codeMu.RLock()
+	defer codeMu.RUnlock()
+	return codeClasses[code]
+}
+
+// RegisterCode maps an additional BingX business code to one of the sentinel
+// errors, or removes the mapping when class is nil. It affects errors created
+// afterwards and is safe for concurrent use.
+func RegisterCode(code string, class error) {
+	codeMu.Lock()
+	defer codeMu.Unlock()
+	if class == nil {
+		delete(codeClasses, code)
+		return
+	}
+	codeClasses[code] = class
+}
+
+// retryableClasses are failures that may succeed when the same request is
+// sent again, after a backoff or with a fresh timestamp.
+var retryableClasses = []error{ErrRateLimited, ErrServerBusy, ErrTimestampOutOfWindow}
+
+// IsRetryable reports whether err is a transient failure worth retrying:
+// rate limiting, an overloaded or failing server (HTTP 5xx or 429 included),
+// a transport failure, or a request that expired before it reached the
+// exchange.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	for _, class := range retryableClasses {
+		if stderrors.Is(err, class) {
+			return true
+		}
+	}
+
+	base, ok := AsBingXException(err)
+	if !ok {
+		return false
+	}
+	if base.StatusCode == 0 && base.Err != nil {
+		// No response was received: the request may never have arrived,
+		// unless the caller gave up.
+		return !stderrors.Is(base.Err, context.Canceled) && !stderrors.Is(base.Err, context.DeadlineExceeded)
+	}
+	status := base.StatusCode
+	if status == 0 {
+		status = base.Code
+	}
+	return status == 429 || status >= 500
+}
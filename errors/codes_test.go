@@ -0,0 +1,93 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+)
+
+func TestAPIException_IsMatchesCodeClass(t *testing.T) {
+	tests := []struct {
+		code  string
+		class error
+	}{
+		{"80016", ErrOrderNotFound},
+		{"109400", ErrInvalidSymbol},
+		{"101485", ErrFilterViolation},
+		{"101290", ErrReduceOnlyRejected},
+		{"101414", ErrPositionModeMismatch},
+		{"100421", ErrTimestampOutOfWindow},
+		{"100419", ErrIPNotWhitelisted},
+		{"101204", ErrInsufficientMargin},
+	}
+
+	for _, tt := range tests {
+		err := fmt.Errorf("wrapped: %w", NewAPIException("msg", tt.code, nil))
+		if !stderrors.Is(err, tt.class) {
+			t.Errorf("code %s should match %v", tt.code, tt.class)
+		}
+		if stderrors.Is(err, ErrAuthentication) {
+			t.Errorf("code %s should not match ErrAuthentication", tt.code)
+		}
+
+		var apiErr *APIException
+		if !stderrors.As(err, &apiErr) || apiErr.APICode != tt.code {
+			t.Errorf("code %s: errors.As should find the APIException", tt.code)
+		}
+	}
+
+	if stderrors.Is(NewAPIException("msg", "999999", nil), ErrOrderNotFound) {
+		t.Error("unknown codes should not match any class")
+	}
+}
+
+func TestTypedExceptions_IsMatchesClass(t *testing.T) {
+	if !stderrors.Is(NewAuthenticationException("m", nil), ErrAuthentication) {
+		t.Error("AuthenticationException should match ErrAuthentication")
+	}
+	if !stderrors.Is(NewRateLimitException("m", nil), ErrRateLimited) {
+		t.Error("RateLimitException should match ErrRateLimited")
+	}
+	if !stderrors.Is(NewInsufficientBalanceException("m", nil), ErrInsufficientBalance) {
+		t.Error("InsufficientBalanceException should match ErrInsufficientBalance")
+	}
+}
+
+func TestRegisterCode(t *testing.T) {
+	defer RegisterCode("123456", nil)
+
+	RegisterCode("123456", ErrOrderNotFound)
+	if !stderrors.Is(NewAPIException("msg", "123456", nil), ErrOrderNotFound) {
+		t.Error("registered code should match its class")
+	}
+
+	RegisterCode("123456", nil)
+	if ClassifyCode("123456") != nil {
+		t.Error("mapping should have been removed")
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"rate limit", NewRateLimitException("m", nil), true},
+		{"server busy", NewAPIException("m", "100503", nil), true},
+		{"stale timestamp", NewAPIException("m", "100421", nil), true},
+		{"HTTP 502", NewBingXException("bad gateway", 502, nil), true},
+		{"HTTP 429", fmt.Errorf("call: %w", NewBingXException("too many", 429, nil)), true},
+		{"HTTP 400", NewBingXException("bad request", 400, nil), false},
+		{"order not found", NewAPIException("m", "80016", nil), false},
+		{"authentication", NewAuthenticationException("m", nil), false},
+		{"other", stderrors.New("boom"), false},
+	}
+
+	for _, tt := range tests {
+		if got := IsRetryable(tt.err); got != tt.want {
+			t.Errorf("%s: IsRetryable() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
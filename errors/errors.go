@@ -1,17 +1,54 @@
 package errors
 
-import "fmt"
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+)
 
 type BingXException struct {
 	Message  string
 	Code     int
 	Response map[string]interface{}
+
+	// StatusCode is the HTTP status of the response, or zero when no
+	// response was received.
+	StatusCode int
+	// Header holds the response headers, including rate-limit usage headers.
+	Header http.Header
+	// Body is the raw response body.
+	Body []byte
+	// Path is the request path, without the query string.
+	Path string
+	// Err is the underlying cause, such as a transport or decoding error.
+	Err error
 }
 
 func (e *BingXException) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the underlying cause, if any.
+func (e *BingXException) Unwrap() error {
+	return e.Err
+}
+
+func (e *BingXException) exception() *BingXException {
+	return e
+}
+
+// AsBingXException returns the BingXException carried by err or by any of
+// the typed exceptions wrapped in err, giving access to the HTTP status,
+// headers, raw body and request path.
+func AsBingXException(err error) (*BingXException, bool) {
+	var target interface{ exception() *BingXException }
+	if !stderrors.As(err, &target) {
+		return nil, false
+	}
+	e := target.exception()
+	return e, e != nil
+}
+
 func (e *BingXException) GetResponse() map[string]interface{} {
 	return e.Response
 }
@@ -33,6 +70,11 @@ func (e *APIException) Error() string {
 	return fmt.Sprintf("API Error [%s]: %s", e.APICode, e.Message)
 }
 
+// Is reports whether the API code belongs to target's class, see ClassifyCode.
+func (e *APIException) Is(target error) bool {
+	return target != nil && target == ClassifyCode(e.APICode)
+}
+
 func NewAPIException(message, apiCode string, response map[string]interface{}) *APIException {
 	return &APIException{
 		BingXException: NewBingXException(message, 0, response),
@@ -48,6 +90,11 @@ func (e *AuthenticationException) Error() string {
 	return fmt.Sprintf("Authentication Error: %s", e.Message)
 }
 
+// Is matches ErrAuthentication.
+func (e *AuthenticationException) Is(target error) bool {
+	return target == ErrAuthentication
+}
+
 func NewAuthenticationException(message string, response map[string]interface{}) *AuthenticationException {
 	return &AuthenticationException{
 		BingXException: NewBingXException(message, 0, response),
@@ -62,6 +109,11 @@ func (e *RateLimitException) Error() string {
 	return fmt.Sprintf("Rate Limit Exceeded: %s", e.Message)
 }
 
+// Is matches ErrRateLimited.
+func (e *RateLimitException) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
 func NewRateLimitException(message string, response map[string]interface{}) *RateLimitException {
 	return &RateLimitException{
 		BingXException: NewBingXException(message, 0, response),
@@ -76,6 +128,11 @@ func (e *InsufficientBalanceException) Error() string {
 	return fmt.Sprintf("Insufficient Balance: %s", e.Message)
 }
 
+// Is matches ErrInsufficientBalance.
+func (e *InsufficientBalanceException) Is(target error) bool {
+	return target == ErrInsufficientBalance
+}
+
 func NewInsufficientBalanceException(message string, response map[string]interface{}) *InsufficientBalanceException {
 	return &InsufficientBalanceException{
 		BingXException: NewBingXException(message, 0, response),
@@ -1,6 +1,8 @@
 package errors
 
 import (
+	stderrors "errors"
+	"fmt"
 	"testing"
 )
 
@@ -102,3 +104,31 @@ func TestNewInsufficientBalanceException(t *testing.T) {
 		t.Errorf("Expected message '%s', got '%s'", message, err.Message)
 	}
 }
+
+func TestBingXExceptionUnwrap(t *testing.T) {
+	cause := stderrors.New("connection refused")
+	err := NewBingXException("HTTP request failed: connection refused", 0, nil)
+	err.Err = cause
+
+	if !stderrors.Is(err, cause) {
+		t.Error("Expected the cause to be reachable through Unwrap")
+	}
+}
+
+func TestAsBingXException(t *testing.T) {
+	apiErr := NewAPIException("order not exist", "80016", nil)
+	apiErr.StatusCode = 200
+	apiErr.Path = "/openApi/swap/v2/trade/order"
+
+	ex, ok := AsBingXException(fmt.Errorf("cancel: %w", apiErr))
+	if !ok {
+		t.Fatal("Expected the embedded BingXException to be found")
+	}
+	if ex.StatusCode != 200 || ex.Path != "/openApi/swap/v2/trade/order" {
+		t.Errorf("Unexpected details: %+v", ex)
+	}
+
+	if _, ok := AsBingXException(stderrors.New("other")); ok {
+		t.Error("Expected no BingXException in a foreign error")
+	}
+}
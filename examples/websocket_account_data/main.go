@@ -8,6 +8,7 @@ import (
 	"syscall"
 
 	"github.com/tigusigalpa/bingx-go/v2"
+	"github.com/tigusigalpa/bingx-go/v2/websocket"
 )
 
 func main() {
@@ -46,16 +47,22 @@ func main() {
 		fmt.Printf("Account Update [%s]: %+v\n", eventType, data)
 	})
 
-	stream.OnBalanceUpdate(func(balances interface{}) {
-		fmt.Printf("Balance Update: %+v\n", balances)
+	stream.OnAccountUpdateEvent(func(event websocket.AccountUpdate) {
+		for _, balance := range event.Account.Balances {
+			fmt.Printf("Balance Update: %s wallet=%s change=%s\n", balance.Asset, balance.WalletBalance, balance.BalanceChange)
+		}
+		for _, position := range event.Account.Positions {
+			fmt.Printf("Position Update: %s %s amount=%s entry=%s\n", position.Symbol, position.PositionSide, position.PositionAmount, position.EntryPrice)
+		}
 	})
 
-	stream.OnPositionUpdate(func(positions interface{}) {
-		fmt.Printf("Position Update: %+v\n", positions)
+	stream.OnOrderTradeUpdate(func(event websocket.OrderTradeUpdate) {
+		order := event.Order
+		fmt.Printf("Order Update: %s %s %s status=%s filled=%s avg=%s\n", order.Symbol, order.Side, order.ExecutionType, order.Status, order.FilledQuantity, order.AveragePrice)
 	})
 
-	stream.OnOrderUpdate(func(order interface{}) {
-		fmt.Printf("Order Update: %+v\n", order)
+	stream.OnAccountConfigUpdate(func(event websocket.AccountConfigUpdate) {
+		fmt.Printf("Config Update: %s leverage=%d/%d margin=%s\n", event.Config.Symbol, event.Config.LongLeverage, event.Config.ShortLeverage, event.Config.MarginType)
 	})
 
 	sigChan := make(chan os.Signal, 1)
@@ -2,47 +2,172 @@ package http
 
 import (
 	"bytes"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/base64"
-	"encoding/hex"
+	"context"
+	"crypto/tls"
+	"encoding"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"math/big"
 	"net/http"
 	"net/url"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/tigusigalpa/bingx-go/v2/errors"
 )
 
+// DefaultTimeout is the overall request timeout used when no custom
+// *http.Client or timeout is supplied.
+const DefaultTimeout = 30 * time.Second
+
 type BaseHTTPClient struct {
 	apiKey            string
 	apiSecret         string
 	baseURI           string
 	sourceKey         string
 	signatureEncoding string
+	signer            Signer
+	credentials       CredentialsProvider
+	lastCredentials   atomic.Pointer[Credentials]
 	httpClient        *http.Client
+	transport         transportSettings
+	retryPolicy       RetryPolicy
+	rateLimiter       *RateLimiter
+	timeSync          *TimeSync
+	recvWindow        time.Duration
+	interceptors      []Interceptor
+	logger            *slog.Logger
+	logLevels         *LogLevels
+	jsonNumbers       bool
+}
+
+// transportSettings collects the transport-related options so they can be
+// applied in a fixed order regardless of the order the options were passed.
+type transportSettings struct {
+	client    *http.Client
+	roundTrip http.RoundTripper
+	timeout   *time.Duration
+	proxy     func(*http.Request) (*url.URL, error)
+	tlsConfig *tls.Config
 }
 
-func NewBaseHTTPClient(apiKey, apiSecret, baseURI, sourceKey, signatureEncoding string) *BaseHTTPClient {
-	return &BaseHTTPClient{
+// Option configures optional behaviour of a BaseHTTPClient.
+type Option func(*BaseHTTPClient)
+
+// WithHTTPClient makes the client send requests through a copy of hc. The
+// caller's *http.Client is never modified by the other options.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *BaseHTTPClient) {
+		c.transport.client = hc
+	}
+}
+
+// WithTransport replaces the RoundTripper used to send requests.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *BaseHTTPClient) {
+		c.transport.roundTrip = rt
+	}
+}
+
+// WithTimeout overrides the overall per-request timeout. Zero disables it.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *BaseHTTPClient) {
+		c.transport.timeout = &timeout
+	}
+}
+
+// WithProxy sets the proxy function, e.g. http.ProxyURL(u). It is applied to
+// the underlying *http.Transport; custom RoundTrippers are left untouched.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) Option {
+	return func(c *BaseHTTPClient) {
+		c.transport.proxy = proxy
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the underlying *http.Transport.
+// Custom RoundTrippers are left untouched.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(c *BaseHTTPClient) {
+		c.transport.tlsConfig = cfg
+	}
+}
+
+func NewBaseHTTPClient(apiKey, apiSecret, baseURI, sourceKey, signatureEncoding string, options ...Option) *BaseHTTPClient {
+	c := &BaseHTTPClient{
 		apiKey:            apiKey,
 		apiSecret:         apiSecret,
 		baseURI:           baseURI,
 		sourceKey:         sourceKey,
 		signatureEncoding: signatureEncoding,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
 	}
+
+	for _, opt := range options {
+		opt(c)
+	}
+
+	if c.logger != nil {
+		levels := DefaultLogLevels()
+		if c.logLevels != nil {
+			levels = *c.logLevels
+		}
+		c.interceptors = append(c.interceptors, c.loggingInterceptor(c.logger, levels))
+	}
+
+	c.httpClient = c.transport.build()
+	return c
+}
+
+func (t transportSettings) build() *http.Client {
+	hc := &http.Client{Timeout: DefaultTimeout}
+	if t.client != nil {
+		clone := *t.client
+		hc = &clone
+	}
+
+	if t.roundTrip != nil {
+		hc.Transport = t.roundTrip
+	}
+	if t.timeout != nil {
+		hc.Timeout = *t.timeout
+	}
+
+	if t.proxy == nil && t.tlsConfig == nil {
+		return hc
+	}
+
+	var transport *http.Transport
+	switch rt := hc.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = rt.Clone()
+	default:
+		return hc
+	}
+
+	if t.proxy != nil {
+		transport.Proxy = t.proxy
+	}
+	if t.tlsConfig != nil {
+		transport.TLSClientConfig = t.tlsConfig.Clone()
+	}
+	hc.Transport = transport
+	return hc
 }
 
 func (c *BaseHTTPClient) timestamp() string {
-	return strconv.FormatInt(time.Now().UnixMilli(), 10)
+	now := time.Now()
+	if c.timeSync != nil {
+		now = c.timeSync.Now()
+	}
+	return strconv.FormatInt(now.UnixMilli(), 10)
 }
 
 func (c *BaseHTTPClient) sortedKeys(params map[string]interface{}) []string {
@@ -104,6 +229,25 @@ func (c *BaseHTTPClient) paramValueToString(v interface{}) (string, bool) {
 		return strconv.FormatBool(*val), true
 	case []byte:
 		return string(val), true
+	case json.Number:
+		return val.String(), true
+	case *big.Float:
+		if val == nil {
+			return "", false
+		}
+		return val.Text('f', -1), true
+	case encoding.TextMarshaler:
+		// Decimal types (decimal.Decimal, shopspring/decimal, *big.Int)
+		// are sent in their exact textual form.
+		if rv := reflect.ValueOf(val); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return "", false
+		}
+		// Empty text, such as the zero decimal.Decimal, means "not set".
+		b, err := val.MarshalText()
+		if err != nil || len(b) == 0 {
+			return "", false
+		}
+		return string(b), true
 	}
 
 	// Complex values (slices, maps, structs) are serialized as JSON.
@@ -137,8 +281,18 @@ func (c *BaseHTTPClient) buildCanonicalString(params map[string]interface{}) str
 // (GET/DELETE query strings). The signature is always appended last and is
 // URL-escaped so legacy base64 signatures remain valid on the wire.
 func (c *BaseHTTPClient) buildSignedString(params map[string]interface{}, signature string, forURL bool) string {
+	signed := "signature=" + url.QueryEscape(signature)
+	if query := c.buildQueryString(params, forURL); query != "" {
+		return query + "&" + signed
+	}
+	return signed
+}
+
+// buildQueryString builds the sorted wire representation of params without a
+// signature, escaping values like buildSignedString does.
+func (c *BaseHTTPClient) buildQueryString(params map[string]interface{}, forURL bool) string {
 	keys := c.sortedKeys(params)
-	parts := make([]string, 0, len(keys)+1)
+	parts := make([]string, 0, len(keys))
 
 	for _, k := range keys {
 		v, ok := c.paramValueToString(params[k])
@@ -151,30 +305,24 @@ func (c *BaseHTTPClient) buildSignedString(params map[string]interface{}, signat
 		parts = append(parts, k+"="+v)
 	}
 
-	parts = append(parts, "signature="+url.QueryEscape(signature))
 	return strings.Join(parts, "&")
 }
 
 func (c *BaseHTTPClient) signString(str string) string {
-	h := hmac.New(sha256.New, []byte(c.apiSecret))
-	h.Write([]byte(str))
-
-	// Hex is the only encoding compatible with BingX's signature authentication.
-	// Keep base64 as an explicit backward-compatible option, but never silently
-	// fall back to base64 for empty or unrecognized encodings.
-	if c.signatureEncoding == "base64" {
-		return base64.StdEncoding.EncodeToString(h.Sum(nil))
-	}
-
-	return hex.EncodeToString(h.Sum(nil))
+	return hmacSign(c.apiSecret, c.signatureEncoding, str)
 }
 
-func (c *BaseHTTPClient) headers() map[string]string {
+// headers returns the request headers. X-BX-APIKEY is omitted when apiKey is
+// empty, as for public requests.
+func (c *BaseHTTPClient) headers(apiKey string) map[string]string {
 	headers := map[string]string{
-		"X-BX-APIKEY":  c.apiKey,
 		"Content-Type": "application/x-www-form-urlencoded",
 	}
 
+	if apiKey != "" {
+		headers["X-BX-APIKEY"] = apiKey
+	}
+
 	if c.sourceKey != "" {
 		headers["X-SOURCE-KEY"] = c.sourceKey
 	}
@@ -194,14 +342,16 @@ func (c *BaseHTTPClient) handleAPIError(response map[string]interface{}) error {
 		message = msg
 	}
 
-	switch codeStr {
-	case "0":
+	if codeStr == "0" {
 		return nil
-	case "100001", "100002", "100003", "100004", "100412":
+	}
+
+	switch errors.ClassifyCode(codeStr) {
+	case errors.ErrAuthentication:
 		return errors.NewAuthenticationException(message, response)
-	case "100005", "100429":
+	case errors.ErrRateLimited:
 		return errors.NewRateLimitException(message, response)
-	case "200001", "200002":
+	case errors.ErrInsufficientBalance:
 		return errors.NewInsufficientBalanceException(message, response)
 	default:
 		return errors.NewAPIException(message, codeStr, response)
@@ -209,35 +359,136 @@ func (c *BaseHTTPClient) handleAPIError(response map[string]interface{}) error {
 }
 
 func (c *BaseHTTPClient) Request(method, path string, params map[string]interface{}) (map[string]interface{}, error) {
-	body, err := c.requestBody(method, path, params)
-	if err != nil {
-		return nil, err
+	return c.RequestContext(context.Background(), method, path, params)
+}
+
+// RequestContext is like Request but carries ctx to the underlying HTTP call,
+// so the request can be cancelled or bounded by a deadline tighter than the
+// client-wide timeout.
+func (c *BaseHTTPClient) RequestContext(ctx context.Context, method, path string, params map[string]interface{}) (map[string]interface{}, error) {
+	return c.decodeMap(path, c.requestBody(ctx, method, path, params, false))
+}
+
+// PublicRequest sends an unsigned request to a public endpoint such as
+// market data. No timestamp, signature or API key is attached, so it works
+// without credentials.
+func (c *BaseHTTPClient) PublicRequest(method, path string, params map[string]interface{}) (map[string]interface{}, error) {
+	return c.PublicRequestContext(context.Background(), method, path, params)
+}
+
+// PublicRequestContext is like PublicRequest but honors ctx for cancellation
+// and deadlines.
+func (c *BaseHTTPClient) PublicRequestContext(ctx context.Context, method, path string, params map[string]interface{}) (map[string]interface{}, error) {
+	return c.decodeMap(path, c.requestBody(ctx, method, path, params, true))
+}
+
+func (c *BaseHTTPClient) decodeMap(path string, result attemptResult) (map[string]interface{}, error) {
+	if result.err != nil {
+		return nil, result.err
 	}
 
 	var data map[string]interface{}
-	if err := json.Unmarshal(body, &data); err != nil {
-		return nil, errors.NewBingXException("Invalid JSON response from API", 0, map[string]interface{}{"raw": string(body)})
+	if err := c.unmarshal(result.body, &data); err != nil {
+		return nil, decodeError(path, result, err)
 	}
 
 	return data, nil
 }
 
 func (c *BaseHTTPClient) RequestJSON(method, path string, params map[string]interface{}, result interface{}) error {
-	body, err := c.requestBody(method, path, params)
-	if err != nil {
-		return err
+	return c.RequestJSONContext(context.Background(), method, path, params, result)
+}
+
+// RequestJSONContext is like RequestJSON but honors ctx for cancellation and
+// deadlines.
+func (c *BaseHTTPClient) RequestJSONContext(ctx context.Context, method, path string, params map[string]interface{}, result interface{}) error {
+	return c.decodeJSON(path, c.requestBody(ctx, method, path, params, false), result)
+}
+
+// PublicRequestJSONContext is the unsigned counterpart of RequestJSONContext,
+// see PublicRequest.
+func (c *BaseHTTPClient) PublicRequestJSONContext(ctx context.Context, method, path string, params map[string]interface{}, result interface{}) error {
+	return c.decodeJSON(path, c.requestBody(ctx, method, path, params, true), result)
+}
+
+func (c *BaseHTTPClient) decodeJSON(path string, call attemptResult, result interface{}) error {
+	if call.err != nil {
+		return call.err
 	}
 
-	if err := json.Unmarshal(body, result); err != nil {
-		return errors.NewBingXException("Invalid JSON response from API", 0, map[string]interface{}{"raw": string(body)})
+	if err := c.unmarshal(call.body, result); err != nil {
+		return decodeError(path, call, err)
 	}
 
 	return nil
 }
 
-func (c *BaseHTTPClient) requestBody(method, path string, params map[string]interface{}) ([]byte, error) {
+func (c *BaseHTTPClient) requestBody(ctx context.Context, method, path string, params map[string]interface{}, public bool) attemptResult {
 	method = strings.ToUpper(method)
+	ctx = c.beforeCall(ctx, method, path)
+	result := c.call(ctx, method, path, params, public)
+	c.afterCall(ctx, result)
+	return result
+}
 
+// decodeError reports a successful response whose body does not decode into
+// the caller's value.
+func decodeError(path string, result attemptResult, cause error) error {
+	ex := errors.NewBingXException("Invalid JSON response from API", 0, map[string]interface{}{"raw": string(result.body)})
+	annotate(ex, path, &result, cause)
+	return ex
+}
+
+// call runs the attempts of a single request, applying the rate limiter and
+// retry policy, and returns the final attempt.
+func (c *BaseHTTPClient) call(ctx context.Context, method, path string, params map[string]interface{}, public bool) attemptResult {
+	retryable := c.retryPolicy.allows(method, params)
+
+	for attempt := 1; ; attempt++ {
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(ctx, path); err != nil {
+				if _, ok := errors.AsBingXException(err); !ok {
+					err = wrapException("Rate limiter wait aborted: "+err.Error(), err)
+				}
+				annotate(err, path, &attemptResult{}, nil)
+				return attemptResult{info: RequestInfo{Method: method, Path: path, Attempt: attempt, Public: public}, err: err}
+			}
+		}
+
+		result := c.send(ctx, method, path, params, attempt, public)
+		retry := result.err != nil && retryable && result.hint.transient && attempt < c.retryPolicy.MaxAttempts
+		c.afterResponse(ctx, result, retry)
+		if !retry {
+			return result
+		}
+
+		timer := time.NewTimer(c.retryPolicy.delay(attempt, result.hint.retryAfter))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result
+		case <-timer.C:
+		}
+	}
+}
+
+// attemptResult is the outcome of a single signed attempt.
+type attemptResult struct {
+	info       RequestInfo
+	body       []byte
+	hint       retryHint
+	statusCode int
+	header     http.Header
+	raw        []byte
+	code       string
+	latency    time.Duration
+	err        error
+}
+
+// send performs a single attempt, signed unless public. Retries of signed
+// requests always carry a fresh timestamp so a request delayed by backoff is
+// not rejected as stale.
+func (c *BaseHTTPClient) send(ctx context.Context, method, path string, params map[string]interface{}, attempt int, public bool) attemptResult {
 	// Do not add the generated timestamp to the caller's map. Reusing a map
 	// across requests should produce a fresh timestamp and must not cause a
 	// surprising mutation outside the client.
@@ -246,14 +497,58 @@ func (c *BaseHTTPClient) requestBody(method, path string, params map[string]inte
 		requestParams[key] = value
 	}
 
-	if _, exists := requestParams["timestamp"]; !exists {
-		requestParams["timestamp"] = c.timestamp()
+	if !public {
+		if _, exists := requestParams["timestamp"]; !exists || attempt > 1 {
+			requestParams["timestamp"] = c.timestamp()
+		}
+
+		if _, exists := requestParams["recvWindow"]; !exists {
+			if window := c.recvWindowFor(ctx); window > 0 {
+				requestParams["recvWindow"] = window.Milliseconds()
+			}
+		}
 	}
 
-	// The canonical string is the raw, sorted, URL-unencoded "key=value&..."
-	// payload that is signed. It must never include the signature parameter.
-	canonical := c.buildCanonicalString(requestParams)
-	signature := c.signString(canonical)
+	result := attemptResult{info: RequestInfo{Method: method, Path: path, Attempt: attempt, Public: public}}
+	c.beforeSign(ctx, &result.info, requestParams)
+
+	var creds Credentials
+	var signature, payload string
+	if public {
+		payload = c.buildQueryString(requestParams, method == "GET" || method == "DELETE")
+	} else {
+		var err error
+		creds, err = c.loadCredentials(ctx)
+		if err != nil {
+			ex := wrapException("Failed to load credentials: "+err.Error(), err)
+			ex.Path = path
+			result.err = ex
+			return result
+		}
+		if creds.APIKey == "" {
+			ex := errors.NewAuthenticationException("API key is required for signed endpoints", nil)
+			ex.Path = path
+			result.err = ex
+			return result
+		}
+
+		// The canonical string is the raw, sorted, URL-unencoded "key=value&..."
+		// payload that is signed. It must never include the signature parameter.
+		canonical := c.buildCanonicalString(requestParams)
+		signature, err = c.sign(ctx, creds, canonical)
+		if err != nil {
+			ex := wrapException("Failed to sign request: "+err.Error(), err)
+			ex.Path = path
+			result.err = ex
+			return result
+		}
+
+		// Signature is appended last, after the canonical query. Values that
+		// contain '[' or '{' are URL-escaped in GET/DELETE query strings, while
+		// the canonical signing string remains raw. POST/PUT bodies are sent
+		// as-is (raw) followed by the signature.
+		payload = c.buildSignedString(requestParams, signature, method == "GET" || method == "DELETE")
+	}
 
 	var req *http.Request
 	var err error
@@ -261,62 +556,164 @@ func (c *BaseHTTPClient) requestBody(method, path string, params map[string]inte
 	fullURL := c.baseURI + path
 
 	if method == "GET" || method == "DELETE" {
-		// Signature is appended last, after the canonical query. Values that
-		// contain '[' or '{' are URL-escaped in the actual query string, while
-		// the canonical signing string remains raw.
-		query := c.buildSignedString(requestParams, signature, true)
-		fullURL = fullURL + "?" + query
-		req, err = http.NewRequest(method, fullURL, nil)
+		if payload != "" {
+			fullURL = fullURL + "?" + payload
+		}
+		req, err = http.NewRequestWithContext(ctx, method, fullURL, nil)
 	} else {
-		// POST/PUT bodies are form-urlencoded. The canonical string is sent as-is
-		// (raw) followed by the signature.
-		body := c.buildSignedString(requestParams, signature, false)
-		req, err = http.NewRequest(method, fullURL, bytes.NewBufferString(body))
+		// POST/PUT bodies are form-urlencoded.
+		req, err = http.NewRequestWithContext(ctx, method, fullURL, bytes.NewBufferString(payload))
 	}
 
 	if err != nil {
-		return nil, errors.NewBingXException("Failed to create request: "+err.Error(), 0, nil)
+		ex := wrapException("Failed to create request: "+err.Error(), err)
+		ex.Path = path
+		result.err = ex
+		return result
 	}
 
-	for k, v := range c.headers() {
+	headers := c.headers(creds.APIKey)
+	for k, v := range headers {
 		req.Header.Set(k, v)
 	}
 
+	c.afterSign(ctx, &result.info, requestParams, signature, headers)
+
+	start := time.Now()
+	c.do(ctx, req, &result)
+	result.latency = time.Since(start)
+
+	if !public && stderrors.Is(result.err, errors.ErrAuthentication) {
+		c.invalidateCredentials()
+	}
+	return result
+}
+
+// sign uses the configured Signer, or HMAC over the request's API secret.
+func (c *BaseHTTPClient) sign(ctx context.Context, creds Credentials, payload string) (string, error) {
+	if c.signer != nil {
+		return c.signer.Sign(ctx, payload)
+	}
+	return hmacSign(creds.APISecret, c.signatureEncoding, payload), nil
+}
+
+// do sends req and decodes the BingX envelope into result. Every error it
+// records carries the request path and whatever response details were
+// received.
+func (c *BaseHTTPClient) do(ctx context.Context, req *http.Request, result *attemptResult) {
+	defer func() {
+		if result.err != nil {
+			annotate(result.err, req.URL.Path, result, nil)
+		}
+	}()
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, errors.NewBingXException("HTTP request failed: "+err.Error(), 0, nil)
+		// Transport failures are transient unless the caller gave up.
+		result.hint = retryHint{transient: ctx.Err() == nil}
+		result.err = wrapException("HTTP request failed: "+err.Error(), err)
+		return
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	result.statusCode = resp.StatusCode
+	result.header = resp.Header
+	result.hint = retryHint{
+		transient:  resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests,
+		retryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, errors.NewBingXException("Failed to read response: "+err.Error(), 0, nil)
+		result.err = wrapException("Failed to read response: "+err.Error(), err)
+		return
 	}
+	result.raw = body
 
 	var data map[string]interface{}
-	if err := json.Unmarshal(body, &data); err != nil {
-		return nil, errors.NewBingXException("Invalid JSON response from API", 0, map[string]interface{}{"raw": string(body)})
+	if err := c.unmarshal(body, &data); err != nil {
+		ex := wrapException("Invalid JSON response from API", err)
+		ex.Response = map[string]interface{}{"raw": string(body)}
+		result.err = ex
+		return
+	}
+
+	if code, ok := data["code"]; ok {
+		result.code = fmt.Sprintf("%v", code)
 	}
 
 	if err := c.handleAPIError(data); err != nil {
-		return nil, err
+		if errors.IsRetryable(err) {
+			result.hint.transient = true
+		}
+		result.err = err
+		return
 	}
 
 	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		return nil, errors.NewBingXException(
+		result.err = errors.NewBingXException(
 			fmt.Sprintf("HTTP request failed with status %s", resp.Status),
 			resp.StatusCode,
 			data,
 		)
+		return
 	}
 
-	return body, nil
+	result.body = body
+	result.hint = retryHint{}
+}
+
+// wrapException creates a BingXException whose Unwrap returns cause.
+func wrapException(message string, cause error) *errors.BingXException {
+	ex := errors.NewBingXException(message, 0, nil)
+	ex.Err = cause
+	return ex
+}
+
+// annotate copies the request path and response details of result onto the
+// BingXException carried by err. cause, when set and err has no cause yet,
+// becomes its Unwrap target.
+func annotate(err error, path string, result *attemptResult, cause error) {
+	ex, ok := errors.AsBingXException(err)
+	if !ok {
+		return
+	}
+	if ex.Path == "" {
+		ex.Path = path
+	}
+	if ex.StatusCode == 0 {
+		ex.StatusCode = result.statusCode
+	}
+	if ex.Header == nil {
+		ex.Header = result.header
+	}
+	if ex.Body == nil {
+		ex.Body = result.raw
+	}
+	if ex.Err == nil {
+		ex.Err = cause
+	}
 }
 
 func (c *BaseHTTPClient) GetEndpoint() string {
 	return c.baseURI
 }
 
+// TimeSync returns the configured clock synchronizer, or nil.
+func (c *BaseHTTPClient) TimeSync() *TimeSync {
+	return c.timeSync
+}
+
+// RateLimiter returns the configured client-side rate limiter, or nil.
+func (c *BaseHTTPClient) RateLimiter() *RateLimiter {
+	return c.rateLimiter
+}
+
+// GetAPIKey returns the API key used for the most recent request when a
+// CredentialsProvider is configured, or the constructor's key otherwise.
 func (c *BaseHTTPClient) GetAPIKey() string {
+	if creds := c.lastCredentials.Load(); creds != nil {
+		return creds.APIKey
+	}
 	return c.apiKey
 }
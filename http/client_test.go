@@ -1,16 +1,24 @@
 package http
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
+	"crypto/tls"
 	"encoding/hex"
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"io"
+	"math/big"
 	nethttp "net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/tigusigalpa/bingx-go/v2/errors"
 )
 
 func TestNewBaseHTTPClient(t *testing.T) {
@@ -94,6 +102,32 @@ func TestBuildCanonicalString(t *testing.T) {
 			},
 			expected: "price=50000.5",
 		},
+		{
+			name: "Decimal params keep their exact text",
+			params: map[string]interface{}{
+				"price":    json.Number("0.30000000000000000001"),
+				"quantity": new(big.Float).SetPrec(200).SetFloat64(1e21),
+				"orderId":  new(big.Int).SetUint64(1736012345678901234),
+				"stop":     testDecimal("12345678901234567890.123456789"),
+			},
+			expected: "orderId=1736012345678901234&price=0.30000000000000000001&quantity=1000000000000000000000&stop=12345678901234567890.123456789",
+		},
+		{
+			name: "Nil decimal pointer is skipped",
+			params: map[string]interface{}{
+				"price":  (*big.Float)(nil),
+				"symbol": "BTC-USDT",
+			},
+			expected: "symbol=BTC-USDT",
+		},
+		{
+			name: "Empty decimal is skipped",
+			params: map[string]interface{}{
+				"stopPrice": testDecimal(""),
+				"symbol":    "BTC-USDT",
+			},
+			expected: "symbol=BTC-USDT",
+		},
 		{
 			name: "Bool param",
 			params: map[string]interface{}{
@@ -130,6 +164,11 @@ func TestBuildCanonicalString(t *testing.T) {
 	}
 }
 
+// testDecimal mimics third-party decimal types such as shopspring/decimal.
+type testDecimal string
+
+func (d testDecimal) MarshalText() ([]byte, error) { return []byte(d), nil }
+
 func TestBuildSignedString(t *testing.T) {
 	client := NewBaseHTTPClient("key", testSignatureSecret, "https://api.test.com", "", "hex")
 
@@ -249,7 +288,7 @@ func TestHeaders(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			client := NewBaseHTTPClient(tt.apiKey, "secret", "https://api.test.com", tt.sourceKey, "base64")
-			headers := client.headers()
+			headers := client.headers(client.apiKey)
 
 			for key, expectedValue := range tt.expected {
 				if headers[key] != expectedValue {
@@ -451,3 +490,232 @@ func TestRequestSignatureIsVerifiedByServer(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestContext_DeadlineAbortsRequest(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+		_, _ = fmt.Fprint(w, `{"code":0}`)
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	client := NewBaseHTTPClient("test-key", testSignatureSecret, srv.URL, "", "hex")
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.RequestContext(ctx, "POST", "/test", map[string]interface{}{"symbol": "BTC-USDT"})
+	if err == nil {
+		t.Fatal("expected an error when the context deadline is exceeded")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("request was not aborted by the context deadline, took %s", elapsed)
+	}
+}
+
+func TestRequestContext_CancelledBeforeSend(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		called = true
+		_, _ = fmt.Fprint(w, `{"code":0}`)
+	}))
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("test-key", testSignatureSecret, srv.URL, "", "hex")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var result map[string]interface{}
+	if err := client.RequestJSONContext(ctx, "GET", "/test", nil, &result); err == nil {
+		t.Fatal("expected an error for an already cancelled context")
+	}
+	if called {
+		t.Error("server should not be reached with a cancelled context")
+	}
+}
+
+type roundTripFunc func(*nethttp.Request) (*nethttp.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *nethttp.Request) (*nethttp.Response, error) {
+	return f(r)
+}
+
+func TestNewBaseHTTPClient_DefaultTimeout(t *testing.T) {
+	client := NewBaseHTTPClient("key", "secret", "https://api.test.com", "", "hex")
+	if client.httpClient.Timeout != DefaultTimeout {
+		t.Errorf("expected default timeout %s, got %s", DefaultTimeout, client.httpClient.Timeout)
+	}
+}
+
+func TestWithHTTPClient_DoesNotMutateCallerClient(t *testing.T) {
+	caller := &nethttp.Client{Timeout: time.Minute}
+	client := NewBaseHTTPClient("key", "secret", "https://api.test.com", "", "hex",
+		WithHTTPClient(caller),
+		WithTimeout(time.Second),
+		WithProxy(nethttp.ProxyURL(&url.URL{Scheme: "http", Host: "proxy.local:3128"})),
+	)
+
+	if caller.Timeout != time.Minute || caller.Transport != nil {
+		t.Error("caller's *http.Client must not be modified")
+	}
+	if client.httpClient == caller {
+		t.Fatal("expected a copy of the caller's client")
+	}
+	if client.httpClient.Timeout != time.Second {
+		t.Errorf("expected timeout override, got %s", client.httpClient.Timeout)
+	}
+
+	transport, ok := client.httpClient.Transport.(*nethttp.Transport)
+	if !ok {
+		t.Fatalf("expected *http.Transport, got %T", client.httpClient.Transport)
+	}
+	req, _ := nethttp.NewRequest("GET", "https://open-api.bingx.com", nil)
+	proxyURL, err := transport.Proxy(req)
+	if err != nil || proxyURL == nil || proxyURL.Host != "proxy.local:3128" {
+		t.Errorf("expected proxy to be configured, got %v (%v)", proxyURL, err)
+	}
+}
+
+func TestWithTransport_RoutesRequests(t *testing.T) {
+	var gotHost, gotPath string
+	rt := roundTripFunc(func(r *nethttp.Request) (*nethttp.Response, error) {
+		gotHost = r.URL.Host
+		gotPath = r.URL.Path
+		return &nethttp.Response{
+			StatusCode: nethttp.StatusOK,
+			Status:     "200 OK",
+			Body:       io.NopCloser(strings.NewReader(`{"code":0,"data":"ok"}`)),
+			Header:     make(nethttp.Header),
+			Request:    r,
+		}, nil
+	})
+
+	client := NewBaseHTTPClient("key", "secret", "https://open-api.bingx.com", "", "hex",
+		WithTransport(rt),
+		WithTLSConfig(&tls.Config{MinVersion: tls.VersionTLS12}),
+	)
+
+	if _, ok := client.httpClient.Transport.(roundTripFunc); !ok {
+		t.Fatalf("custom RoundTripper must not be replaced, got %T", client.httpClient.Transport)
+	}
+
+	resp, err := client.Request("GET", "/openApi/swap/v2/quote/depth", map[string]interface{}{"symbol": "BTC-USDT"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp["data"] != "ok" {
+		t.Errorf("unexpected response: %v", resp)
+	}
+	if gotHost != "open-api.bingx.com" || gotPath != "/openApi/swap/v2/quote/depth" {
+		t.Errorf("unexpected request target %s%s", gotHost, gotPath)
+	}
+}
+
+func TestErrors_PreserveResponseDetails(t *testing.T) {
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.Header().Set("X-RateLimit-Requests-Remain", "0")
+		w.WriteHeader(nethttp.StatusBadGateway)
+		_, _ = fmt.Fprint(w, `{"code":80016,"msg":"order not exist"}`)
+	}))
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex")
+	_, err := client.Request("GET", "/openApi/swap/v2/trade/order", map[string]interface{}{"symbol": "BTC-USDT"})
+
+	var apiErr *errors.APIException
+	if !stderrors.As(err, &apiErr) || apiErr.APICode != "80016" {
+		t.Fatalf("expected the business error, got %T: %v", err, err)
+	}
+	ex, ok := errors.AsBingXException(err)
+	if !ok {
+		t.Fatal("expected details to be available")
+	}
+	if ex.StatusCode != nethttp.StatusBadGateway {
+		t.Errorf("expected status 502, got %d", ex.StatusCode)
+	}
+	if ex.Path != "/openApi/swap/v2/trade/order" {
+		t.Errorf("unexpected path %q", ex.Path)
+	}
+	if ex.Header.Get("X-RateLimit-Requests-Remain") != "0" {
+		t.Errorf("expected response headers, got %v", ex.Header)
+	}
+	if !strings.Contains(string(ex.Body), "order not exist") {
+		t.Errorf("expected the raw body, got %q", ex.Body)
+	}
+}
+
+func TestErrors_UnwrapToTransportError(t *testing.T) {
+	transportErr := stderrors.New("connection reset by peer")
+	client := NewBaseHTTPClient("key", "secret", "https://open-api.bingx.com", "", "hex",
+		WithTransport(roundTripFunc(func(r *nethttp.Request) (*nethttp.Response, error) {
+			return nil, transportErr
+		})),
+	)
+
+	_, err := client.Request("GET", "/openApi/swap/v2/quote/depth", nil)
+	if !stderrors.Is(err, transportErr) {
+		t.Fatalf("expected the transport error in the chain, got %v", err)
+	}
+	var urlErr *url.Error
+	if !stderrors.As(err, &urlErr) {
+		t.Errorf("expected a *url.Error in the chain, got %v", err)
+	}
+	ex, _ := errors.AsBingXException(err)
+	if ex == nil || ex.StatusCode != 0 || ex.Path != "/openApi/swap/v2/quote/depth" {
+		t.Errorf("unexpected details: %+v", ex)
+	}
+	if !errors.IsRetryable(err) {
+		t.Error("transport failures should be retryable")
+	}
+}
+
+func TestErrors_DecodeFailureKeepsStatusAndCause(t *testing.T) {
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		_, _ = fmt.Fprint(w, `{"code":0,"data":"not-an-object"}`)
+	}))
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex")
+	var result struct {
+		Data struct{ Symbol string } `json:"data"`
+	}
+	err := client.RequestJSON("GET", "/test", nil, &result)
+
+	var typeErr *json.UnmarshalTypeError
+	if !stderrors.As(err, &typeErr) {
+		t.Fatalf("expected the decoding error in the chain, got %v", err)
+	}
+	ex, _ := errors.AsBingXException(err)
+	if ex == nil || ex.StatusCode != nethttp.StatusOK || ex.Path != "/test" || len(ex.Body) == 0 {
+		t.Errorf("unexpected details: %+v", ex)
+	}
+}
+
+func TestErrors_ContextErrorsStayInChain(t *testing.T) {
+	client := NewBaseHTTPClient("key", "secret", "https://open-api.bingx.com", "", "hex",
+		WithRateLimiter(NewRateLimiter(RateLimitBlock, RateLimitRule{Prefix: "/p", Limit: 1, Interval: time.Hour})),
+		WithTransport(roundTripFunc(func(r *nethttp.Request) (*nethttp.Response, error) {
+			return &nethttp.Response{StatusCode: nethttp.StatusOK, Body: io.NopCloser(strings.NewReader(`{"code":0}`)), Header: make(nethttp.Header)}, nil
+		})),
+	)
+	if _, err := client.Request("GET", "/p", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := client.RequestContext(ctx, "GET", "/p", nil)
+	if !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded in the chain, got %v", err)
+	}
+	if ex, _ := errors.AsBingXException(err); ex == nil || ex.Path != "/p" {
+		t.Errorf("unexpected details: %+v", ex)
+	}
+	if errors.IsRetryable(err) {
+		t.Error("an expired context is not retryable")
+	}
+}
@@ -0,0 +1,121 @@
+package http
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Credentials is an API key pair. APISecret may be empty when requests are
+// signed by a custom Signer.
+type Credentials struct {
+	APIKey    string
+	APISecret string
+}
+
+// CredentialsProvider supplies the credentials for each signed request, which
+// allows keys to be rotated without rebuilding the client.
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// CredentialsProviderFunc adapts a function to the CredentialsProvider
+// interface.
+type CredentialsProviderFunc func(ctx context.Context) (Credentials, error)
+
+// Credentials calls f.
+func (f CredentialsProviderFunc) Credentials(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// StaticCredentials always returns the same key pair.
+func StaticCredentials(apiKey, apiSecret string) CredentialsProvider {
+	creds := Credentials{APIKey: apiKey, APISecret: apiSecret}
+	return CredentialsProviderFunc(func(context.Context) (Credentials, error) {
+		return creds, nil
+	})
+}
+
+// CachedCredentials caches the credentials of a slower provider, such as a
+// Vault client, for a fixed time.
+type CachedCredentials struct {
+	provider CredentialsProvider
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	creds   Credentials
+	expires time.Time
+	valid   bool
+}
+
+// NewCachedCredentials wraps provider so it is consulted at most once per
+// ttl. If a refresh fails, the error is returned and the next request tries
+// again; expired credentials are never reused.
+func NewCachedCredentials(provider CredentialsProvider, ttl time.Duration) *CachedCredentials {
+	return &CachedCredentials{
+		provider: provider,
+		ttl:      ttl,
+		now:      time.Now,
+	}
+}
+
+// Credentials returns the cached pair, refreshing it when it has expired.
+func (c *CachedCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.valid && c.now().Before(c.expires) {
+		return c.creds, nil
+	}
+
+	creds, err := c.provider.Credentials(ctx)
+	if err != nil {
+		c.valid = false
+		return Credentials{}, err
+	}
+	c.creds = creds
+	c.expires = c.now().Add(c.ttl)
+	c.valid = true
+	return creds, nil
+}
+
+// Invalidate drops the cached pair so the next request refreshes it. The
+// client calls it automatically when BingX rejects the credentials.
+func (c *CachedCredentials) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.valid = false
+}
+
+// WithCredentialsProvider makes the client fetch the API key and secret from
+// provider for every signed request instead of using the constructor
+// arguments. Wrap slow providers with NewCachedCredentials.
+func WithCredentialsProvider(provider CredentialsProvider) Option {
+	return func(c *BaseHTTPClient) {
+		c.credentials = provider
+	}
+}
+
+// loadCredentials returns the credentials for one attempt and remembers them
+// so logs can scrub them.
+func (c *BaseHTTPClient) loadCredentials(ctx context.Context) (Credentials, error) {
+	if c.credentials == nil {
+		return Credentials{APIKey: c.apiKey, APISecret: c.apiSecret}, nil
+	}
+
+	creds, err := c.credentials.Credentials(ctx)
+	if err != nil {
+		return Credentials{}, err
+	}
+	c.lastCredentials.Store(&creds)
+	return creds, nil
+}
+
+// invalidateCredentials asks a caching provider to refresh after BingX
+// rejected the credentials, e.g. because they were rotated.
+func (c *BaseHTTPClient) invalidateCredentials() {
+	if inv, ok := c.credentials.(interface{ Invalidate() }); ok {
+		inv.Invalidate()
+	}
+}
@@ -0,0 +1,160 @@
+package http
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tigusigalpa/bingx-go/v2/errors"
+)
+
+// rotatingVault hands out a new key pair every time rotate is called.
+type rotatingVault struct {
+	mu    sync.Mutex
+	gen   int
+	calls int
+	err   error
+}
+
+func (v *rotatingVault) Credentials(context.Context) (Credentials, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.calls++
+	if v.err != nil {
+		return Credentials{}, v.err
+	}
+	return v.pair(), nil
+}
+
+// current returns the valid pair without counting as a provider call.
+func (v *rotatingVault) current() Credentials {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.pair()
+}
+
+func (v *rotatingVault) pair() Credentials {
+	return Credentials{APIKey: fmt.Sprintf("key-%d", v.gen), APISecret: fmt.Sprintf("secret-%d", v.gen)}
+}
+
+func (v *rotatingVault) rotate() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.gen++
+}
+
+// newKeyCheckingServer accepts only requests signed with the current vault
+// generation and answers 100413 otherwise.
+func newKeyCheckingServer(t *testing.T, vault *rotatingVault) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		current := vault.current()
+		query := r.URL.Query()
+		signature := query.Get("signature")
+		query.Del("signature")
+
+		if r.Header.Get("X-BX-APIKEY") != current.APIKey || signature != hmacSign(current.APISecret, "hex", query.Encode()) {
+			_, _ = fmt.Fprint(w, `{"code":100413,"msg":"incorrect apiKey"}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"code":0,"data":{}}`)
+	}))
+}
+
+func TestWithCredentialsProvider_RotatesWithoutRebuild(t *testing.T) {
+	vault := &rotatingVault{}
+	srv := newKeyCheckingServer(t, vault)
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("", "", srv.URL, "", "hex", WithCredentialsProvider(vault))
+	if _, err := client.Request("GET", "/test", nil); err != nil {
+		t.Fatalf("first generation should be accepted: %v", err)
+	}
+
+	vault.rotate()
+	if _, err := client.Request("GET", "/test", nil); err != nil {
+		t.Fatalf("rotated credentials should be used: %v", err)
+	}
+	if client.GetAPIKey() != "key-1" {
+		t.Errorf("GetAPIKey() = %q, want key-1", client.GetAPIKey())
+	}
+}
+
+func TestCachedCredentials_RefreshesAfterTTLAndAuthFailure(t *testing.T) {
+	vault := &rotatingVault{}
+	srv := newKeyCheckingServer(t, vault)
+	defer srv.Close()
+
+	cached := NewCachedCredentials(vault, time.Hour)
+	client := NewBaseHTTPClient("", "", srv.URL, "", "hex", WithCredentialsProvider(cached))
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.Request("GET", "/test", nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if vault.calls != 1 {
+		t.Errorf("expected the vault to be consulted once, got %d", vault.calls)
+	}
+
+	vault.rotate()
+	_, err := client.Request("GET", "/test", nil)
+	if !stderrors.Is(err, errors.ErrAuthentication) {
+		t.Fatalf("stale cached key should be rejected, got %v", err)
+	}
+	if _, err := client.Request("GET", "/test", nil); err != nil {
+		t.Fatalf("cache should have been invalidated by the rejection: %v", err)
+	}
+	if vault.calls != 2 {
+		t.Errorf("expected a single refresh, got %d vault calls", vault.calls)
+	}
+}
+
+func TestCachedCredentials_ExpiresAndReportsErrors(t *testing.T) {
+	vault := &rotatingVault{}
+	cached := NewCachedCredentials(vault, time.Minute)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cached.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if _, err := cached.Credentials(ctx); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(2 * time.Minute)
+	vault.err = stderrors.New("vault sealed")
+	if _, err := cached.Credentials(ctx); err == nil {
+		t.Fatal("expired credentials must not be reused when the refresh fails")
+	}
+
+	vault.err = nil
+	vault.rotate()
+	creds, err := cached.Credentials(ctx)
+	if err != nil || creds.APIKey != "key-1" {
+		t.Errorf("expected the refreshed pair, got %+v, %v", creds, err)
+	}
+}
+
+func TestWithCredentialsProvider_ErrorAbortsRequest(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		hits++
+	}))
+	defer srv.Close()
+
+	vaultErr := stderrors.New("vault sealed")
+	client := NewBaseHTTPClient("", "", srv.URL, "", "hex", WithCredentialsProvider(CredentialsProviderFunc(
+		func(context.Context) (Credentials, error) { return Credentials{}, vaultErr },
+	)))
+
+	if _, err := client.Request("GET", "/test", nil); !stderrors.Is(err, vaultErr) {
+		t.Fatalf("expected the provider error in the chain, got %v", err)
+	}
+	if hits != 0 {
+		t.Error("no request should be sent without credentials")
+	}
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/tigusigalpa/bingx-go/v2/errors"
+)
+
+// Envelope is the {code, msg, data} wrapper of every BingX response.
+type Envelope[T any] struct {
+	Code json.Number `json:"code"`
+	Msg  string      `json:"msg"`
+	Data T           `json:"data"`
+}
+
+// Do sends a signed request and decodes the "data" field of the response
+// into T. Business errors are reported exactly as by RequestContext; a
+// response without data is an error.
+//
+//	ticker, err := http.Do[BookTicker](ctx, client, "GET", path, params)
+func Do[T any](ctx context.Context, c *BaseHTTPClient, method, path string, params map[string]interface{}) (T, error) {
+	return decodeData[T](c, path, c.requestBody(ctx, method, path, params, false))
+}
+
+// DoPublic is the unsigned counterpart of Do, see PublicRequest.
+func DoPublic[T any](ctx context.Context, c *BaseHTTPClient, method, path string, params map[string]interface{}) (T, error) {
+	return decodeData[T](c, path, c.requestBody(ctx, method, path, params, true))
+}
+
+func decodeData[T any](c *BaseHTTPClient, path string, result attemptResult) (T, error) {
+	var zero T
+	if result.err != nil {
+		return zero, result.err
+	}
+
+	// Data stays raw so that it is decoded with the client's number
+	// settings below.
+	var envelope Envelope[json.RawMessage]
+	if err := json.Unmarshal(result.body, &envelope); err != nil {
+		return zero, decodeError(path, result, err)
+	}
+	if len(envelope.Data) == 0 || string(envelope.Data) == "null" {
+		ex := errors.NewBingXException("BingX response is missing data", 0, nil)
+		annotate(ex, path, &result, nil)
+		return zero, ex
+	}
+
+	var data T
+	if err := c.unmarshal(envelope.Data, &data); err != nil {
+		return zero, decodeError(path, result, err)
+	}
+	return data, nil
+}
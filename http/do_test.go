@@ -0,0 +1,91 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tigusigalpa/bingx-go/v2/errors"
+)
+
+type testPosition struct {
+	Symbol   string  `json:"symbol"`
+	Leverage int     `json:"leverage"`
+	Amount   float64 `json:"positionAmt,string"`
+}
+
+func newEnvelopeServer(t *testing.T, body string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		_, _ = fmt.Fprint(w, body)
+	}))
+}
+
+func TestDo_DecodesData(t *testing.T) {
+	srv := newEnvelopeServer(t, `{"code":0,"msg":"","data":[{"symbol":"BTC-USDT","leverage":10,"positionAmt":"0.5"}]}`)
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex")
+	positions, err := Do[[]testPosition](context.Background(), client, "GET", "/openApi/swap/v2/user/positions", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(positions) != 1 || positions[0].Symbol != "BTC-USDT" || positions[0].Leverage != 10 || positions[0].Amount != 0.5 {
+		t.Errorf("unexpected positions: %+v", positions)
+	}
+}
+
+func TestDo_BusinessErrorIsReturned(t *testing.T) {
+	srv := newEnvelopeServer(t, `{"code":80016,"msg":"order not exist"}`)
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex")
+	_, err := Do[map[string]interface{}](context.Background(), client, "GET", "/openApi/swap/v2/trade/order", nil)
+	if !stderrors.Is(err, errors.ErrOrderNotFound) {
+		t.Fatalf("expected errors.ErrOrderNotFound, got %v", err)
+	}
+}
+
+func TestDo_MissingAndMalformedData(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"missing", `{"code":0,"msg":""}`},
+		{"null", `{"code":0,"msg":"","data":null}`},
+		{"wrong shape", `{"code":0,"msg":"","data":{"symbol":"BTC-USDT"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newEnvelopeServer(t, tt.body)
+			defer srv.Close()
+
+			client := NewBaseHTTPClient("", "", srv.URL, "", "hex")
+			_, err := DoPublic[[]testPosition](context.Background(), client, "GET", "/test", nil)
+			ex, ok := errors.AsBingXException(err)
+			if !ok {
+				t.Fatalf("expected a BingXException, got %v", err)
+			}
+			if ex.Path != "/test" || ex.StatusCode != nethttp.StatusOK || len(ex.Body) == 0 {
+				t.Errorf("expected response details, got %+v", ex)
+			}
+		})
+	}
+}
+
+func TestEnvelope_DecodesStringAndNumberCodes(t *testing.T) {
+	for _, body := range []string{`{"code":0,"msg":"","data":"x"}`, `{"code":"0","msg":"","data":"x"}`} {
+		var env Envelope[string]
+		if err := json.Unmarshal([]byte(body), &env); err != nil {
+			t.Fatalf("%s: %v", body, err)
+		}
+		if env.Code.String() != "0" || env.Data != "x" {
+			t.Errorf("%s: unexpected envelope %+v", body, env)
+		}
+	}
+}
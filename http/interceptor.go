@@ -0,0 +1,190 @@
+package http
+
+import (
+	"context"
+	"time"
+)
+
+// Redacted replaces sensitive values in the parameters handed to interceptors.
+const Redacted = "[REDACTED]"
+
+// sensitiveParams are parameter names whose values must never leave the
+// client through interceptors or logs.
+var sensitiveParams = map[string]bool{
+	"signature": true,
+	"apiKey":    true,
+	"apiSecret": true,
+	"secretKey": true,
+	"listenKey": true,
+}
+
+// sensitiveHeaders are header names whose values are always Redacted.
+var sensitiveHeaders = map[string]bool{
+	"X-BX-APIKEY": true,
+}
+
+// RequestInfo describes one signed attempt of a REST call.
+type RequestInfo struct {
+	Method string
+	Path   string
+	// Params holds the stringified request parameters, including the
+	// generated timestamp and recvWindow. Sensitive values are Redacted.
+	// After signing it also contains the (redacted) signature.
+	Params map[string]string
+	// Headers holds the request headers once signed, with the API key
+	// Redacted. It is nil in BeforeSign.
+	Headers map[string]string
+	// Attempt starts at 1 and grows with every retry.
+	Attempt int
+	// Public reports an unsigned request sent with PublicRequest.
+	Public bool
+}
+
+// ResponseInfo describes the outcome of one attempt.
+type ResponseInfo struct {
+	Request RequestInfo
+	// StatusCode is zero when no HTTP response was received.
+	StatusCode int
+	// Code is the BingX business code from the response envelope, or empty
+	// when the body could not be decoded.
+	Code    string
+	Latency time.Duration
+	Err     error
+	// ErrMessage is Err's message with credentials and signed URL
+	// parameters Redacted, the form WithLogger writes. Use it instead of
+	// Err.Error() when exporting the error.
+	ErrMessage string
+	// WillRetry reports whether the retry policy will send another attempt.
+	WillRetry bool
+}
+
+// Interceptor observes REST calls. Any hook may be nil. Hooks run
+// synchronously on the calling goroutine, in registration order.
+type Interceptor struct {
+	// BeforeCall runs once per call, before rate limiting and the first
+	// attempt. A non-nil returned context replaces ctx for the rest of the
+	// call, including the HTTP requests and the remaining hooks, which lets
+	// tracers attach a span.
+	BeforeCall func(ctx context.Context, method, path string) context.Context
+	// BeforeSign, AfterSign and AfterResponse run once per attempt. Public
+	// requests are never signed, but still pass through both sign hooks.
+	BeforeSign    func(ctx context.Context, req RequestInfo)
+	AfterSign     func(ctx context.Context, req RequestInfo)
+	AfterResponse func(ctx context.Context, resp ResponseInfo)
+	// AfterCall runs once per call with the outcome of the final attempt.
+	// resp.Request.Attempt is the number of attempts made.
+	AfterCall func(ctx context.Context, resp ResponseInfo)
+}
+
+// WithInterceptors appends interceptors to the client's chain.
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(c *BaseHTTPClient) {
+		c.interceptors = append(c.interceptors, interceptors...)
+	}
+}
+
+func (c *BaseHTTPClient) beforeCall(ctx context.Context, method, path string) context.Context {
+	for _, i := range c.interceptors {
+		if i.BeforeCall != nil {
+			if next := i.BeforeCall(ctx, method, path); next != nil {
+				ctx = next
+			}
+		}
+	}
+	return ctx
+}
+
+func (c *BaseHTTPClient) afterCall(ctx context.Context, result attemptResult) {
+	if len(c.interceptors) == 0 {
+		return
+	}
+	resp := c.newResponseInfo(result, false)
+	for _, i := range c.interceptors {
+		if i.AfterCall != nil {
+			i.AfterCall(ctx, resp)
+		}
+	}
+}
+
+func (c *BaseHTTPClient) beforeSign(ctx context.Context, info *RequestInfo, params map[string]interface{}) {
+	if len(c.interceptors) == 0 {
+		return
+	}
+	info.Params = c.redactParams(params, "")
+	for _, i := range c.interceptors {
+		if i.BeforeSign != nil {
+			i.BeforeSign(ctx, *info)
+		}
+	}
+}
+
+func (c *BaseHTTPClient) afterSign(ctx context.Context, info *RequestInfo, params map[string]interface{}, signature string, headers map[string]string) {
+	if len(c.interceptors) == 0 {
+		return
+	}
+	info.Params = c.redactParams(params, signature)
+	info.Headers = redactHeaders(headers)
+	for _, i := range c.interceptors {
+		if i.AfterSign != nil {
+			i.AfterSign(ctx, *info)
+		}
+	}
+}
+
+func (c *BaseHTTPClient) afterResponse(ctx context.Context, result attemptResult, willRetry bool) {
+	if len(c.interceptors) == 0 {
+		return
+	}
+	resp := c.newResponseInfo(result, willRetry)
+	for _, i := range c.interceptors {
+		if i.AfterResponse != nil {
+			i.AfterResponse(ctx, resp)
+		}
+	}
+}
+
+func (c *BaseHTTPClient) newResponseInfo(result attemptResult, willRetry bool) ResponseInfo {
+	resp := ResponseInfo{
+		Request:    result.info,
+		StatusCode: result.statusCode,
+		Code:       result.code,
+		Latency:    result.latency,
+		Err:        result.err,
+		WillRetry:  willRetry,
+	}
+	if result.err != nil {
+		resp.ErrMessage = c.scrub(result.err.Error())
+	}
+	return resp
+}
+
+// redactParams stringifies params the same way they are sent and masks
+// sensitive values. A non-empty signature is included, masked.
+func (c *BaseHTTPClient) redactParams(params map[string]interface{}, signature string) map[string]string {
+	redacted := make(map[string]string, len(params)+1)
+	for k, v := range params {
+		value, ok := c.paramValueToString(v)
+		if !ok {
+			continue
+		}
+		if sensitiveParams[k] {
+			value = Redacted
+		}
+		redacted[k] = value
+	}
+	if signature != "" {
+		redacted["signature"] = Redacted
+	}
+	return redacted
+}
+
+func redactHeaders(headers map[string]string) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for k, v := range headers {
+		if sensitiveHeaders[k] {
+			v = Redacted
+		}
+		redacted[k] = v
+	}
+	return redacted
+}
@@ -0,0 +1,154 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestInterceptors_ReceiveRedactedCallDetails(t *testing.T) {
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		_, _ = fmt.Fprint(w, `{"code":0,"data":{}}`)
+	}))
+	defer srv.Close()
+
+	var events []string
+	var before, after RequestInfo
+	var response ResponseInfo
+
+	record := func(name string) Interceptor {
+		return Interceptor{
+			BeforeSign: func(ctx context.Context, req RequestInfo) { events = append(events, name+":before"); before = req },
+			AfterSign:  func(ctx context.Context, req RequestInfo) { events = append(events, name+":after"); after = req },
+			AfterResponse: func(ctx context.Context, resp ResponseInfo) {
+				events = append(events, name+":response")
+				response = resp
+			},
+		}
+	}
+
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex",
+		WithInterceptors(record("a")),
+		WithInterceptors(record("b"), Interceptor{}),
+	)
+	_, err := client.Request("post", "/openApi/subAccount/v1/apiKey/edit", map[string]interface{}{
+		"apiKey":    "sub-account-key",
+		"symbol":    "BTC-USDT",
+		"timestamp": "1702731500000",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"a:before", "b:before", "a:after", "b:after", "a:response", "b:response"}
+	if fmt.Sprint(events) != fmt.Sprint(want) {
+		t.Errorf("hook order = %v, want %v", events, want)
+	}
+
+	if before.Method != "POST" || before.Path != "/openApi/subAccount/v1/apiKey/edit" || before.Attempt != 1 {
+		t.Errorf("unexpected request info: %+v", before)
+	}
+	if before.Params["apiKey"] != Redacted || before.Params["symbol"] != "BTC-USDT" {
+		t.Errorf("unexpected before-sign params: %v", before.Params)
+	}
+	if _, ok := before.Params["signature"]; ok {
+		t.Error("before-sign params must not contain a signature")
+	}
+	if after.Params["signature"] != Redacted || after.Params["timestamp"] != "1702731500000" {
+		t.Errorf("unexpected after-sign params: %v", after.Params)
+	}
+
+	if response.StatusCode != nethttp.StatusOK || response.Code != "0" || response.Err != nil || response.WillRetry {
+		t.Errorf("unexpected response info: %+v", response)
+	}
+	if response.Latency <= 0 {
+		t.Error("latency should be measured")
+	}
+}
+
+func TestInterceptors_ReportFailuresAndRetries(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			w.WriteHeader(nethttp.StatusServiceUnavailable)
+			_, _ = fmt.Fprint(w, `{"code":100429,"msg":"busy"}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"code":101204,"msg":"insufficient margin"}`)
+	}))
+	defer srv.Close()
+
+	var responses []ResponseInfo
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex",
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}),
+		WithInterceptors(Interceptor{
+			AfterResponse: func(ctx context.Context, resp ResponseInfo) { responses = append(responses, resp) },
+		}),
+	)
+
+	if _, err := client.Request("GET", "/test", nil); err == nil {
+		t.Fatal("expected the business error")
+	}
+	if len(responses) != 2 {
+		t.Fatalf("expected 2 attempts to be reported, got %d", len(responses))
+	}
+
+	first, second := responses[0], responses[1]
+	if first.StatusCode != nethttp.StatusServiceUnavailable || first.Code != "100429" || !first.WillRetry || first.Request.Attempt != 1 {
+		t.Errorf("unexpected first attempt: %+v", first)
+	}
+	if second.Code != "101204" || second.Err == nil || second.WillRetry || second.Request.Attempt != 2 {
+		t.Errorf("unexpected second attempt: %+v", second)
+	}
+}
+
+type callKey struct{}
+
+func TestInterceptors_CallHooksWrapAllAttempts(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if atomic.AddInt32(&hits, 1) < 3 {
+			w.WriteHeader(nethttp.StatusBadGateway)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"code":0}`)
+	}))
+	defer srv.Close()
+
+	var calls, attemptsSeenWithCallCtx int
+	var final ResponseInfo
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex",
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}),
+		WithInterceptors(Interceptor{
+			BeforeCall: func(ctx context.Context, method, path string) context.Context {
+				calls++
+				return context.WithValue(ctx, callKey{}, path)
+			},
+			AfterResponse: func(ctx context.Context, resp ResponseInfo) {
+				if ctx.Value(callKey{}) == "/test" {
+					attemptsSeenWithCallCtx++
+				}
+			},
+			AfterCall: func(ctx context.Context, resp ResponseInfo) {
+				final = resp
+			},
+		}),
+	)
+
+	if _, err := client.Request("GET", "/test", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("BeforeCall should run once, ran %d times", calls)
+	}
+	if attemptsSeenWithCallCtx != 3 {
+		t.Errorf("expected 3 attempts to see the BeforeCall context, got %d", attemptsSeenWithCallCtx)
+	}
+	if final.Request.Attempt != 3 || final.Code != "0" || final.Err != nil {
+		t.Errorf("unexpected final call info: %+v", final)
+	}
+}
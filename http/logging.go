@@ -0,0 +1,130 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// sensitiveQuery matches sensitive parameters embedded in URLs, such as the
+// signed URL quoted in transport errors.
+var sensitiveQuery = regexp.MustCompile(`(signature|apiKey|apiSecret|secretKey|listenKey)=[^&\s"]*`)
+
+// LogLevels selects the level of each kind of record written by the logger.
+type LogLevels struct {
+	// Request is used for every signed attempt before it is sent.
+	Request slog.Level
+	// Response is used for successful attempts.
+	Response slog.Level
+	// Failure is used for failed attempts, including ones that are retried.
+	Failure slog.Level
+}
+
+// DefaultLogLevels logs traffic at debug level and failures at warn level.
+func DefaultLogLevels() LogLevels {
+	return LogLevels{
+		Request:  slog.LevelDebug,
+		Response: slog.LevelDebug,
+		Failure:  slog.LevelWarn,
+	}
+}
+
+// WithLogger writes a structured record for every request attempt and its
+// outcome to logger. The API key, secret and signature are never logged.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *BaseHTTPClient) {
+		c.logger = logger
+	}
+}
+
+// WithLogLevels overrides DefaultLogLevels for the logger set by WithLogger.
+func WithLogLevels(levels LogLevels) Option {
+	return func(c *BaseHTTPClient) {
+		c.logLevels = &levels
+	}
+}
+
+// loggingInterceptor adapts logger to the interceptor chain so it sees the
+// same redacted parameters as any other interceptor.
+func (c *BaseHTTPClient) loggingInterceptor(logger *slog.Logger, levels LogLevels) Interceptor {
+	return Interceptor{
+		AfterSign: func(ctx context.Context, req RequestInfo) {
+			if !logger.Enabled(ctx, levels.Request) {
+				return
+			}
+			logger.LogAttrs(ctx, levels.Request, "bingx request",
+				slog.String("method", req.Method),
+				slog.String("path", req.Path),
+				slog.Int("attempt", req.Attempt),
+				stringMapAttr("params", req.Params),
+				stringMapAttr("headers", req.Headers),
+			)
+		},
+		AfterResponse: func(ctx context.Context, resp ResponseInfo) {
+			level := levels.Response
+			if resp.Err != nil {
+				level = levels.Failure
+			}
+			if !logger.Enabled(ctx, level) {
+				return
+			}
+
+			attrs := []slog.Attr{
+				slog.String("method", resp.Request.Method),
+				slog.String("path", resp.Request.Path),
+				slog.Int("attempt", resp.Request.Attempt),
+				slog.Int("status", resp.StatusCode),
+				slog.String("code", resp.Code),
+				slog.Duration("latency", resp.Latency),
+			}
+			if resp.Err == nil {
+				logger.LogAttrs(ctx, level, "bingx response", attrs...)
+				return
+			}
+
+			attrs = append(attrs,
+				slog.String("error", resp.ErrMessage),
+				slog.Bool("will_retry", resp.WillRetry),
+				stringMapAttr("params", resp.Request.Params),
+			)
+			logger.LogAttrs(ctx, level, "bingx request failed", attrs...)
+		},
+	}
+}
+
+func stringMapAttr(key string, values map[string]string) slog.Attr {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]any, 0, len(keys))
+	for _, k := range keys {
+		attrs = append(attrs, slog.String(k, values[k]))
+	}
+	return slog.Group(key, attrs...)
+}
+
+// RedactQuery replaces the values of sensitive URL parameters, such as
+// signature and listenKey, in free-form text like an error message.
+func RedactQuery(text string) string {
+	return sensitiveQuery.ReplaceAllString(text, "$1="+Redacted)
+}
+
+// scrub removes credentials from free-form text such as error messages.
+func (c *BaseHTTPClient) scrub(text string) string {
+	text = RedactQuery(text)
+	secrets := []string{c.apiSecret, c.apiKey}
+	if creds := c.lastCredentials.Load(); creds != nil {
+		secrets = append(secrets, creds.APISecret, creds.APIKey)
+	}
+	for _, secret := range secrets {
+		if secret != "" {
+			text = strings.ReplaceAll(text, secret, Redacted)
+		}
+	}
+	return text
+}
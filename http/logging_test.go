@@ -0,0 +1,73 @@
+package http
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithLogger_LogsRedactedRequestAndFailure(t *testing.T) {
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		_, _ = fmt.Fprint(w, `{"code":80014,"msg":"invalid parameter"}`)
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := NewBaseHTTPClient("my-api-key", testSignatureSecret, srv.URL, "", "hex", WithLogger(logger))
+
+	if _, err := client.Request("GET", "/openApi/swap/v2/trade/order", map[string]interface{}{"symbol": "BTC-USDT"}); err == nil {
+		t.Fatal("expected the API error")
+	}
+
+	out := buf.String()
+	for _, want := range []string{`"msg":"bingx request"`, `"msg":"bingx request failed"`, `"level":"WARN"`, `"code":"80014"`, `"symbol":"BTC-USDT"`, `"X-BX-APIKEY":"[REDACTED]"`, `"signature":"[REDACTED]"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log output missing %s:\n%s", want, out)
+		}
+	}
+	for _, secret := range []string{"my-api-key", testSignatureSecret} {
+		if strings.Contains(out, secret) {
+			t.Errorf("log output leaks %q:\n%s", secret, out)
+		}
+	}
+}
+
+func TestWithLogLevels_FiltersRecords(t *testing.T) {
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		_, _ = fmt.Fprint(w, `{"code":0,"data":{}}`)
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex",
+		WithLogger(logger),
+		WithLogLevels(LogLevels{Request: slog.LevelDebug, Response: slog.LevelInfo, Failure: slog.LevelError}),
+	)
+
+	if _, err := client.Request("GET", "/test", nil); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if strings.Contains(out, `msg="bingx request" `) {
+		t.Errorf("debug request record should be filtered:\n%s", out)
+	}
+	if !strings.Contains(out, `msg="bingx response"`) {
+		t.Errorf("expected an info response record:\n%s", out)
+	}
+}
+
+func TestScrub_RemovesCredentialsFromErrors(t *testing.T) {
+	client := NewBaseHTTPClient("my-api-key", "my-secret", "https://example.invalid", "", "hex")
+
+	got := client.scrub(`Get "https://example.invalid/p?listenKey=lk&signature=abc123": dial tcp: my-secret my-api-key`)
+	want := `Get "https://example.invalid/p?listenKey=[REDACTED]&signature=[REDACTED]": dial tcp: [REDACTED] [REDACTED]`
+	if got != want {
+		t.Errorf("scrub() = %s, want %s", got, want)
+	}
+}
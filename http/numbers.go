@@ -0,0 +1,35 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// WithJSONNumbers decodes JSON numbers in untyped responses into json.Number
+// instead of float64, which keeps 19-digit order IDs exact where float64
+// would round them.
+func WithJSONNumbers() Option {
+	return func(c *BaseHTTPClient) {
+		c.jsonNumbers = true
+	}
+}
+
+// unmarshal decodes a response body into v. Numbers stored in interface{}
+// values become json.Number when WithJSONNumbers is set.
+func (c *BaseHTTPClient) unmarshal(data []byte, v interface{}) error {
+	if !c.jsonNumbers {
+		return json.Unmarshal(data, v)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return fmt.Errorf("invalid data after top-level JSON value")
+	}
+	return nil
+}
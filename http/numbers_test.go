@@ -0,0 +1,71 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResponses_KeepLargeOrderIDsExact(t *testing.T) {
+	var cancelledID string
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		switch r.Method {
+		case nethttp.MethodPost:
+			_, _ = fmt.Fprint(w, `{"code":0,"msg":"","data":{"order":{"orderId":1736012345678901234,"price":"64250.1"}}}`)
+		case nethttp.MethodDelete:
+			cancelledID = r.URL.Query().Get("orderId")
+			_, _ = fmt.Fprint(w, `{"code":0,"msg":"","data":{}}`)
+		}
+	}))
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex", WithJSONNumbers())
+	created, err := client.Request("POST", "/openApi/swap/v2/trade/order", map[string]interface{}{"symbol": "BTC-USDT"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	order := created["data"].(map[string]interface{})["order"].(map[string]interface{})
+	orderID, ok := order["orderId"].(json.Number)
+	if !ok || orderID != "1736012345678901234" {
+		t.Fatalf("orderId = %#v, want json.Number", order["orderId"])
+	}
+
+	if _, err := client.Request("DELETE", "/openApi/swap/v2/trade/order", map[string]interface{}{"orderId": orderID}); err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	if cancelledID != "1736012345678901234" {
+		t.Errorf("cancel sent orderId %q", cancelledID)
+	}
+}
+
+func TestResponses_DecodeFloatsByDefault(t *testing.T) {
+	srv := newEnvelopeServer(t, `{"code":0,"msg":"","data":{"orderId":1736012345678901234}}`)
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex")
+	resp, err := client.RequestContext(context.Background(), "GET", "/test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := resp["data"].(map[string]interface{})["orderId"].(float64); !ok {
+		t.Errorf("orderId = %T, want float64", resp["data"].(map[string]interface{})["orderId"])
+	}
+}
+
+func TestUnmarshal_RejectsTrailingData(t *testing.T) {
+	client := NewBaseHTTPClient("key", "secret", "https://api.test.com", "", "hex", WithJSONNumbers())
+
+	var v map[string]interface{}
+	if err := client.unmarshal([]byte(`{"code":0} {"code":1}`), &v); err == nil {
+		t.Error("expected an error for trailing data")
+	}
+	if err := client.unmarshal([]byte(" {\"code\":0}\n"), &v); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if v["code"] != json.Number("0") {
+		t.Errorf("code = %#v, want json.Number", v["code"])
+	}
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/tigusigalpa/bingx-go/v2/errors"
+)
+
+func TestPublicRequest_SkipsSigning(t *testing.T) {
+	var gotQuery string
+	var gotHeader nethttp.Header
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		gotQuery = r.URL.RawQuery
+		gotHeader = r.Header
+		_, _ = fmt.Fprint(w, `{"code":0,"data":{"bids":[]}}`)
+	}))
+	defer srv.Close()
+
+	var info RequestInfo
+	client := NewBaseHTTPClient("my-api-key", "secret", srv.URL, "source", "hex",
+		WithRecvWindow(5*time.Second),
+		WithInterceptors(Interceptor{AfterSign: func(_ context.Context, req RequestInfo) { info = req }}),
+	)
+
+	resp, err := client.PublicRequest("GET", "/openApi/swap/v2/quote/depth", map[string]interface{}{"symbol": "BTC-USDT", "limit": 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp["data"] == nil {
+		t.Errorf("unexpected response: %v", resp)
+	}
+	if gotQuery != "limit=5&symbol=BTC-USDT" {
+		t.Errorf("expected no timestamp, recvWindow or signature, got %q", gotQuery)
+	}
+	if gotHeader.Get("X-BX-APIKEY") != "" {
+		t.Error("the API key must not be sent on public requests")
+	}
+	if gotHeader.Get("X-SOURCE-KEY") != "source" {
+		t.Error("the source key should still be sent")
+	}
+	if !info.Public || info.Params["signature"] != "" {
+		t.Errorf("interceptors should see an unsigned public request, got %+v", info)
+	}
+}
+
+func TestPublicRequest_WithoutParamsHasNoQuery(t *testing.T) {
+	var gotURL string
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		gotURL = r.URL.String()
+		_, _ = fmt.Fprint(w, `{"code":0,"data":{"serverTime":1}}`)
+	}))
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("", "", srv.URL, "", "hex")
+	var result map[string]interface{}
+	if err := client.PublicRequestJSONContext(context.Background(), "GET", "/openApi/swap/v2/market/time", nil, &result); err != nil {
+		t.Fatal(err)
+	}
+	if gotURL != "/openApi/swap/v2/market/time" {
+		t.Errorf("unexpected URL %q", gotURL)
+	}
+}
+
+func TestSignedRequest_RequiresAPIKey(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		hits++
+	}))
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("", "", srv.URL, "", "hex")
+	_, err := client.Request("GET", "/openApi/swap/v2/user/balance", nil)
+	if !stderrors.Is(err, errors.ErrAuthentication) {
+		t.Fatalf("expected an authentication error, got %v", err)
+	}
+	if hits != 0 {
+		t.Error("a signed request without credentials must not be sent")
+	}
+}
@@ -0,0 +1,339 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tigusigalpa/bingx-go/v2/errors"
+)
+
+// RateLimitMode selects what the limiter does when a budget is exhausted.
+type RateLimitMode int
+
+const (
+	// RateLimitBlock waits until a token becomes available or the request
+	// context is done.
+	RateLimitBlock RateLimitMode = iota
+	// RateLimitFailFast rejects the request immediately with a
+	// *errors.RateLimitException.
+	RateLimitFailFast
+)
+
+// RateLimitRule is a token-bucket budget shared by every request whose path
+// starts with Prefix. When several rules match, the longest prefix wins.
+type RateLimitRule struct {
+	Prefix string
+	// Limit is the number of requests allowed per Interval.
+	Limit    int
+	Interval time.Duration
+	// Burst is the bucket capacity. Zero means Limit.
+	Burst int
+}
+
+// RateLimitMetrics is a snapshot of one rule's usage.
+type RateLimitMetrics struct {
+	Prefix string
+	// Allowed counts requests that got a token, including after waiting.
+	Allowed uint64
+	// Throttled counts requests that had to wait in blocking mode.
+	Throttled uint64
+	// Rejected counts requests refused in fail-fast mode or abandoned
+	// because their context ended while waiting.
+	Rejected uint64
+	// WaitTime is the total time spent waiting for tokens.
+	WaitTime time.Duration
+	// Available is the number of tokens currently in the bucket.
+	Available float64
+}
+
+// DefaultRateLimitRules returns conservative budgets for the main BingX
+// endpoint groups. BingX limits differ per account tier; use SetRule or
+// SeedFromAPIRateLimits to match your actual quota.
+func DefaultRateLimitRules() []RateLimitRule {
+	return []RateLimitRule{
+		{Prefix: "/openApi/swap/v2/quote", Limit: 100, Interval: 10 * time.Second},
+		{Prefix: "/openApi/swap/v3/quote", Limit: 100, Interval: 10 * time.Second},
+		{Prefix: "/openApi/swap/v2/trade", Limit: 10, Interval: time.Second},
+		{Prefix: "/openApi/swap/v2/user", Limit: 100, Interval: 10 * time.Second},
+		{Prefix: "/openApi/spot/v1", Limit: 100, Interval: 10 * time.Second},
+		{Prefix: "/openApi/cswap/v1", Limit: 100, Interval: 10 * time.Second},
+	}
+}
+
+type tokenBucket struct {
+	rule     RateLimitRule
+	capacity float64
+	tokens   float64
+	perSec   float64
+	updated  time.Time
+	metrics  RateLimitMetrics
+}
+
+func newTokenBucket(rule RateLimitRule, now time.Time) *tokenBucket {
+	capacity := rule.Burst
+	if capacity <= 0 {
+		capacity = rule.Limit
+	}
+	return &tokenBucket{
+		rule:     rule,
+		capacity: float64(capacity),
+		tokens:   float64(capacity),
+		perSec:   float64(rule.Limit) / rule.Interval.Seconds(),
+		updated:  now,
+		metrics:  RateLimitMetrics{Prefix: rule.Prefix},
+	}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.updated).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * b.perSec
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+	}
+	b.updated = now
+}
+
+// take consumes a token and returns zero, or returns how long to wait until
+// one is available.
+func (b *tokenBucket) take(now time.Time) time.Duration {
+	b.refill(now)
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	missing := 1 - b.tokens
+	return time.Duration(missing / b.perSec * float64(time.Second))
+}
+
+// RateLimiter throttles outgoing requests per endpoint group before they are
+// signed and sent.
+type RateLimiter struct {
+	mu      sync.Mutex
+	mode    RateLimitMode
+	buckets map[string]*tokenBucket
+	now     func() time.Time
+}
+
+// NewRateLimiter creates a limiter with the given mode and rules. Paths that
+// match no rule are not throttled.
+func NewRateLimiter(mode RateLimitMode, rules ...RateLimitRule) *RateLimiter {
+	l := &RateLimiter{
+		mode:    mode,
+		buckets: make(map[string]*tokenBucket),
+		now:     time.Now,
+	}
+	for _, rule := range rules {
+		l.SetRule(rule)
+	}
+	return l
+}
+
+// WithRateLimiter throttles every request through limiter.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *BaseHTTPClient) {
+		c.rateLimiter = limiter
+	}
+}
+
+// SetRule adds or replaces the budget for rule.Prefix. Rules with a
+// non-positive Limit or Interval remove the prefix instead.
+func (l *RateLimiter) SetRule(rule RateLimitRule) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if rule.Limit <= 0 || rule.Interval <= 0 {
+		delete(l.buckets, rule.Prefix)
+		return
+	}
+
+	bucket := newTokenBucket(rule, l.now())
+	if existing, ok := l.buckets[rule.Prefix]; ok {
+		bucket.metrics = existing.metrics
+		bucket.metrics.Prefix = rule.Prefix
+	}
+	l.buckets[rule.Prefix] = bucket
+}
+
+// Rules returns the configured rules sorted by prefix.
+func (l *RateLimiter) Rules() []RateLimitRule {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	rules := make([]RateLimitRule, 0, len(l.buckets))
+	for _, b := range l.buckets {
+		rules = append(rules, b.rule)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Prefix < rules[j].Prefix })
+	return rules
+}
+
+// Metrics returns a usage snapshot for every rule, sorted by prefix.
+func (l *RateLimiter) Metrics() []RateLimitMetrics {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	metrics := make([]RateLimitMetrics, 0, len(l.buckets))
+	for _, b := range l.buckets {
+		b.refill(now)
+		m := b.metrics
+		m.Available = b.tokens
+		metrics = append(metrics, m)
+	}
+	sort.Slice(metrics, func(i, j int) bool { return metrics[i].Prefix < metrics[j].Prefix })
+	return metrics
+}
+
+// match returns the bucket with the longest prefix of path. Callers hold mu.
+func (l *RateLimiter) match(path string) *tokenBucket {
+	var best *tokenBucket
+	for prefix, b := range l.buckets {
+		if strings.HasPrefix(path, prefix) && (best == nil || len(prefix) > len(best.rule.Prefix)) {
+			best = b
+		}
+	}
+	return best
+}
+
+// Wait blocks until a request to path may be sent. In fail-fast mode it
+// returns a *errors.RateLimitException instead of waiting.
+func (l *RateLimiter) Wait(ctx context.Context, path string) error {
+	var waited time.Duration
+	for {
+		l.mu.Lock()
+		b := l.match(path)
+		if b == nil {
+			l.mu.Unlock()
+			return nil
+		}
+
+		wait := b.take(l.now())
+		if wait == 0 {
+			b.metrics.Allowed++
+			b.metrics.WaitTime += waited
+			l.mu.Unlock()
+			return nil
+		}
+
+		if l.mode == RateLimitFailFast {
+			b.metrics.Rejected++
+			prefix := b.rule.Prefix
+			l.mu.Unlock()
+			return errors.NewRateLimitException(
+				fmt.Sprintf("client-side rate limit for %s exhausted, retry in %s", prefix, wait),
+				map[string]interface{}{"prefix": prefix, "retryAfterMs": wait.Milliseconds()},
+			)
+		}
+
+		if waited == 0 {
+			b.metrics.Throttled++
+		}
+		l.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			l.mu.Lock()
+			if b := l.match(path); b != nil {
+				b.metrics.Rejected++
+				b.metrics.WaitTime += waited
+			}
+			l.mu.Unlock()
+			return ctx.Err()
+		case <-timer.C:
+			waited += wait
+		}
+	}
+}
+
+// SeedFromAPIRateLimits updates rules from an AccountService.GetAPIRateLimits
+// response. BingX does not document the shape of this response, so each
+// entry of the "data" list (or of a list nested one level inside "data") is
+// read as {"path"|"prefix", "limit", "interval"} with the interval in
+// milliseconds, and entries that do not have this shape are ignored.
+//
+// It returns the number of rules applied, and an error when the response
+// carries data but none of it could be read as a rule, so that a change of
+// the response shape does not go unnoticed. The current rules are kept then.
+func (l *RateLimiter) SeedFromAPIRateLimits(response map[string]interface{}) (int, error) {
+	var entries []interface{}
+	switch data := response["data"].(type) {
+	case []interface{}:
+		entries = data
+	case map[string]interface{}:
+		for _, v := range data {
+			if list, ok := v.([]interface{}); ok {
+				entries = append(entries, list...)
+			}
+		}
+	}
+
+	applied := 0
+	for _, entry := range entries {
+		fields, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		prefix, _ := fields["path"].(string)
+		if prefix == "" {
+			prefix, _ = fields["prefix"].(string)
+		}
+		limit, okLimit := numericField(fields["limit"])
+		interval, okInterval := numericField(fields["interval"])
+		if prefix == "" || !okLimit || !okInterval || limit <= 0 || interval <= 0 {
+			continue
+		}
+
+		l.SetRule(RateLimitRule{
+			Prefix:   prefix,
+			Limit:    int(limit),
+			Interval: time.Duration(interval) * time.Millisecond,
+		})
+		applied++
+	}
+
+	if applied == 0 && hasData(response["data"]) {
+		return 0, fmt.Errorf("rate limit response has no rules in the expected {path, limit, interval} shape")
+	}
+	return applied, nil
+}
+
+func hasData(data interface{}) bool {
+	switch v := data.(type) {
+	case nil:
+		return false
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
+func numericField(v interface{}) (float64, bool) {
+	switch val := v.(type) {
+	case float64:
+		return val, true
+	case json.Number:
+		f, err := val.Float64()
+		return f, err == nil
+	case int:
+		return float64(val), true
+	case int64:
+		return float64(val), true
+	case string:
+		f, err := strconv.ParseFloat(val, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
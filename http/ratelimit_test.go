@@ -0,0 +1,200 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tigusigalpa/bingx-go/v2/errors"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (f *fakeClock) Now() time.Time { return f.now }
+
+func newTestLimiter(mode RateLimitMode, rules ...RateLimitRule) (*RateLimiter, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	l := NewRateLimiter(mode)
+	l.now = clock.Now
+	for _, rule := range rules {
+		l.SetRule(rule)
+	}
+	return l, clock
+}
+
+func TestRateLimiter_FailFastAndRefill(t *testing.T) {
+	l, clock := newTestLimiter(RateLimitFailFast, RateLimitRule{Prefix: "/openApi/swap/v2/trade", Limit: 2, Interval: time.Second})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := l.Wait(ctx, "/openApi/swap/v2/trade/order"); err != nil {
+			t.Fatalf("request %d should be allowed: %v", i+1, err)
+		}
+	}
+
+	err := l.Wait(ctx, "/openApi/swap/v2/trade/order")
+	if _, ok := err.(*errors.RateLimitException); !ok {
+		t.Fatalf("expected *errors.RateLimitException, got %T: %v", err, err)
+	}
+
+	clock.now = clock.now.Add(500 * time.Millisecond)
+	if err := l.Wait(ctx, "/openApi/swap/v2/trade/order"); err != nil {
+		t.Fatalf("a token should have been refilled: %v", err)
+	}
+
+	m := l.Metrics()
+	if len(m) != 1 || m[0].Allowed != 3 || m[0].Rejected != 1 {
+		t.Errorf("unexpected metrics: %+v", m)
+	}
+}
+
+func TestRateLimiter_LongestPrefixWins(t *testing.T) {
+	l, _ := newTestLimiter(RateLimitFailFast,
+		RateLimitRule{Prefix: "/openApi/", Limit: 100, Interval: time.Second},
+		RateLimitRule{Prefix: "/openApi/swap/v2/trade", Limit: 1, Interval: time.Second},
+	)
+	ctx := context.Background()
+
+	if err := l.Wait(ctx, "/openApi/swap/v2/trade/order"); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Wait(ctx, "/openApi/swap/v2/trade/order"); err == nil {
+		t.Fatal("trade budget should be exhausted")
+	}
+	if err := l.Wait(ctx, "/openApi/swap/v2/quote/depth"); err != nil {
+		t.Fatalf("other groups must not share the trade budget: %v", err)
+	}
+	if err := l.Wait(ctx, "/unmatched/path"); err != nil {
+		t.Fatalf("unmatched paths are not throttled: %v", err)
+	}
+}
+
+func TestRateLimiter_BlockingWaits(t *testing.T) {
+	l := NewRateLimiter(RateLimitBlock, RateLimitRule{Prefix: "/p", Limit: 1, Interval: 30 * time.Millisecond})
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(ctx, "/p"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected the limiter to block, finished in %s", elapsed)
+	}
+
+	m := l.Metrics()[0]
+	if m.Allowed != 3 || m.Throttled != 2 || m.WaitTime <= 0 {
+		t.Errorf("unexpected metrics: %+v", m)
+	}
+}
+
+func TestRateLimiter_BlockingHonorsContext(t *testing.T) {
+	l := NewRateLimiter(RateLimitBlock, RateLimitRule{Prefix: "/p", Limit: 1, Interval: time.Hour})
+	if err := l.Wait(context.Background(), "/p"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx, "/p"); err != context.DeadlineExceeded {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestRateLimiter_SetRuleRemovesWithZeroLimit(t *testing.T) {
+	l := NewRateLimiter(RateLimitFailFast, DefaultRateLimitRules()...)
+	if len(l.Rules()) != len(DefaultRateLimitRules()) {
+		t.Fatalf("expected %d rules, got %d", len(DefaultRateLimitRules()), len(l.Rules()))
+	}
+
+	l.SetRule(RateLimitRule{Prefix: "/openApi/spot/v1"})
+	for _, rule := range l.Rules() {
+		if rule.Prefix == "/openApi/spot/v1" {
+			t.Fatal("rule should have been removed")
+		}
+	}
+}
+
+func TestRateLimiter_SeedFromAPIRateLimits(t *testing.T) {
+	l := NewRateLimiter(RateLimitFailFast)
+	applied, err := l.SeedFromAPIRateLimits(map[string]interface{}{
+		"code": float64(0),
+		"data": []interface{}{
+			map[string]interface{}{"path": "/openApi/swap/v2/trade", "limit": float64(5), "interval": float64(1000)},
+			map[string]interface{}{"prefix": "/openApi/spot/v1", "limit": "50", "interval": "10000"},
+			map[string]interface{}{"path": "/missing/limit", "interval": float64(1000)},
+			"garbage",
+		},
+	})
+	if err != nil || applied != 2 {
+		t.Fatalf("expected 2 rules applied, got %d, %v", applied, err)
+	}
+
+	rules := l.Rules()
+	if rules[0].Prefix != "/openApi/spot/v1" || rules[0].Limit != 50 || rules[0].Interval != 10*time.Second {
+		t.Errorf("unexpected spot rule: %+v", rules[0])
+	}
+	if rules[1].Prefix != "/openApi/swap/v2/trade" || rules[1].Limit != 5 || rules[1].Interval != time.Second {
+		t.Errorf("unexpected trade rule: %+v", rules[1])
+	}
+}
+
+func TestRateLimiter_SeedFromAPIRateLimits_UnknownShape(t *testing.T) {
+	rule := RateLimitRule{Prefix: "/openApi/swap/v2/trade", Limit: 5, Interval: time.Second}
+	l := NewRateLimiter(RateLimitFailFast, rule)
+
+	var response map[string]interface{}
+	body := `{"code":0,"msg":"","data":{"rateLimits":[{"rateLimitType":"REQUEST_WEIGHT","intervalNum":1,"limit":2000}]}}`
+	if err := json.Unmarshal([]byte(body), &response); err != nil {
+		t.Fatal(err)
+	}
+	applied, err := l.SeedFromAPIRateLimits(response)
+	if err == nil || applied != 0 {
+		t.Fatalf("expected an error for an unknown shape, got %d, %v", applied, err)
+	}
+	if rules := l.Rules(); len(rules) != 1 || rules[0] != rule {
+		t.Errorf("rules changed: %+v", rules)
+	}
+
+	for _, empty := range []map[string]interface{}{
+		{"code": float64(0)},
+		{"code": float64(0), "data": []interface{}{}},
+	} {
+		if applied, err := l.SeedFromAPIRateLimits(empty); err != nil || applied != 0 {
+			t.Errorf("SeedFromAPIRateLimits(%v) = %d, %v, want no rules and no error", empty, applied, err)
+		}
+	}
+}
+
+func TestWithRateLimiter_RejectsBeforeSending(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		atomic.AddInt32(&hits, 1)
+		_, _ = fmt.Fprint(w, `{"code":0}`)
+	}))
+	defer srv.Close()
+
+	limiter := NewRateLimiter(RateLimitFailFast, RateLimitRule{Prefix: "/openApi/swap/v2/trade", Limit: 1, Interval: time.Hour})
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex", WithRateLimiter(limiter))
+
+	if _, err := client.Request("POST", "/openApi/swap/v2/trade/order", nil); err != nil {
+		t.Fatalf("first request should pass: %v", err)
+	}
+	if _, err := client.Request("POST", "/openApi/swap/v2/trade/order", nil); err == nil {
+		t.Fatal("second request should be rejected by the limiter")
+	}
+	if n := atomic.LoadInt32(&hits); n != 1 {
+		t.Errorf("expected the server to be hit once, got %d", n)
+	}
+	if client.RateLimiter() != limiter {
+		t.Error("RateLimiter() should return the configured limiter")
+	}
+}
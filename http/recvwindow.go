@@ -0,0 +1,34 @@
+package http
+
+import (
+	"context"
+	"time"
+)
+
+type recvWindowKey struct{}
+
+// WithRecvWindow sets the default recvWindow sent with every signed request
+// that does not carry its own recvWindow parameter. BingX expects the value
+// in milliseconds; sub-millisecond precision is dropped.
+func WithRecvWindow(window time.Duration) Option {
+	return func(c *BaseHTTPClient) {
+		c.recvWindow = window
+	}
+}
+
+// ContextWithRecvWindow returns a context that overrides the client's
+// default recvWindow for requests made with it, e.g. to tighten the window
+// on a latency-sensitive order path. An explicit recvWindow parameter still
+// takes precedence.
+func ContextWithRecvWindow(ctx context.Context, window time.Duration) context.Context {
+	return context.WithValue(ctx, recvWindowKey{}, window)
+}
+
+// recvWindowFor resolves the recvWindow for a request: the context override
+// first, then the client default. Zero means none.
+func (c *BaseHTTPClient) recvWindowFor(ctx context.Context) time.Duration {
+	if window, ok := ctx.Value(recvWindowKey{}).(time.Duration); ok {
+		return window
+	}
+	return c.recvWindow
+}
@@ -0,0 +1,84 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func newRecvWindowServer(t *testing.T) (*httptest.Server, chan url.Values) {
+	t.Helper()
+	received := make(chan url.Values, 1)
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		received <- r.URL.Query()
+		_, _ = fmt.Fprint(w, `{"code":0}`)
+	}))
+	return srv, received
+}
+
+func TestRecvWindow_Resolution(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  []Option
+		ctx      context.Context
+		params   map[string]interface{}
+		expected string
+	}{
+		{
+			name:     "none configured",
+			ctx:      context.Background(),
+			expected: "",
+		},
+		{
+			name:     "client default",
+			options:  []Option{WithRecvWindow(5 * time.Second)},
+			ctx:      context.Background(),
+			expected: "5000",
+		},
+		{
+			name:     "context override",
+			options:  []Option{WithRecvWindow(5 * time.Second)},
+			ctx:      ContextWithRecvWindow(context.Background(), 500*time.Millisecond),
+			expected: "500",
+		},
+		{
+			name:     "context override without default",
+			ctx:      ContextWithRecvWindow(context.Background(), 60*time.Second),
+			expected: "60000",
+		},
+		{
+			name:     "explicit parameter wins",
+			options:  []Option{WithRecvWindow(5 * time.Second)},
+			ctx:      ContextWithRecvWindow(context.Background(), 500*time.Millisecond),
+			params:   map[string]interface{}{"recvWindow": int64(1000)},
+			expected: "1000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, received := newRecvWindowServer(t)
+			defer srv.Close()
+
+			client := NewBaseHTTPClient("key", testSignatureSecret, srv.URL, "", "hex", tt.options...)
+			if _, err := client.RequestContext(tt.ctx, "GET", "/test", tt.params); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			values := <-received
+			if got := values.Get("recvWindow"); got != tt.expected {
+				t.Errorf("recvWindow = %q, want %q", got, tt.expected)
+			}
+
+			sig := values.Get("signature")
+			values.Del("signature")
+			if sig != expectedHexSignature(values.Encode()) {
+				t.Error("recvWindow must be covered by the signature")
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package http
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls automatic retries of transient failures: transport
+// errors, HTTP 5xx and 429 responses, and BingX rate-limit rejections.
+//
+// GET requests are retried whenever the policy is enabled. POST requests are
+// retried only when RetryPOSTWithClientOrderID is set and the request carries
+// a clientOrderId, which lets BingX deduplicate a resent order. Every retry is
+// re-signed with a fresh timestamp.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the exponential delay and a server's Retry-After.
+	// Zero means no cap.
+	MaxBackoff time.Duration
+	// Multiplier grows the delay between consecutive retries. Values below 1
+	// are treated as 1.
+	Multiplier float64
+	// Jitter randomly shortens each delay by up to this fraction (0..1).
+	Jitter float64
+	// RetryPOSTWithClientOrderID opts POST requests that carry a
+	// clientOrderId into retries.
+	RetryPOSTWithClientOrderID bool
+}
+
+// DefaultRetryPolicy returns a policy with three attempts and exponential
+// backoff starting at 200ms.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// WithRetryPolicy enables automatic retries according to policy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *BaseHTTPClient) {
+		c.retryPolicy = policy
+	}
+}
+
+// retryHint describes whether a failed attempt may be retried and how long
+// the server asked us to wait.
+type retryHint struct {
+	transient  bool
+	retryAfter time.Duration
+}
+
+func (p RetryPolicy) allows(method string, params map[string]interface{}) bool {
+	if p.MaxAttempts < 2 {
+		return false
+	}
+
+	switch method {
+	case http.MethodGet:
+		return true
+	case http.MethodPost:
+		if !p.RetryPOSTWithClientOrderID {
+			return false
+		}
+		id, ok := params["clientOrderId"]
+		return ok && id != nil && id != ""
+	default:
+		return false
+	}
+}
+
+// delay returns the wait before the retry following the given attempt. A
+// server-provided Retry-After takes precedence, up to MaxBackoff.
+func (p RetryPolicy) delay(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		if p.MaxBackoff > 0 && retryAfter > p.MaxBackoff {
+			return p.MaxBackoff
+		}
+		return retryAfter
+	}
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	backoff := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		backoff -= backoff * jitter * rand.Float64()
+	}
+
+	return time.Duration(backoff)
+}
+
+// parseRetryAfter understands both forms of the Retry-After header: a number
+// of seconds and an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+
+	return 0
+}
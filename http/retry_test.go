@@ -0,0 +1,263 @@
+package http
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tigusigalpa/bingx-go/v2/errors"
+)
+
+func fastRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		Multiplier:     2,
+	}
+}
+
+// newFlakyServer fails the first `failures` requests with the given status
+// and body, then answers with {"code":0}. It records the signed parameters of
+// every attempt.
+func newFlakyServer(t *testing.T, failures, status int, failBody string) (*httptest.Server, func() []url.Values) {
+	t.Helper()
+	var mu sync.Mutex
+	var attempts []url.Values
+
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		raw := r.URL.RawQuery
+		if r.Method == "POST" {
+			b, _ := io.ReadAll(r.Body)
+			raw = string(b)
+		}
+		values, _ := url.ParseQuery(raw)
+
+		mu.Lock()
+		attempts = append(attempts, values)
+		n := len(attempts)
+		mu.Unlock()
+
+		if n <= failures {
+			w.WriteHeader(status)
+			_, _ = fmt.Fprint(w, failBody)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"code":0,"data":{}}`)
+	}))
+
+	return srv, func() []url.Values {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]url.Values(nil), attempts...)
+	}
+}
+
+func TestRetry_GETRetriesServerErrorsWithFreshTimestamp(t *testing.T) {
+	srv, attempts := newFlakyServer(t, 2, nethttp.StatusServiceUnavailable, `<html>unavailable</html>`)
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("key", testSignatureSecret, srv.URL, "", "hex", WithRetryPolicy(fastRetryPolicy()))
+	_, err := client.Request("GET", "/test", map[string]interface{}{
+		"symbol":    "BTC-USDT",
+		"timestamp": "1702731500000",
+	})
+	if err != nil {
+		t.Fatalf("expected success after retries, got %v", err)
+	}
+
+	got := attempts()
+	if len(got) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(got))
+	}
+	if got[0].Get("timestamp") != "1702731500000" {
+		t.Errorf("first attempt should keep the caller's timestamp, got %s", got[0].Get("timestamp"))
+	}
+	for i, values := range got[1:] {
+		if values.Get("timestamp") == "1702731500000" {
+			t.Errorf("retry %d reused the stale timestamp", i+1)
+		}
+		sig := values.Get("signature")
+		values.Del("signature")
+		if sig != expectedHexSignature(values.Encode()) {
+			t.Errorf("retry %d was not re-signed", i+1)
+		}
+	}
+}
+
+func TestRetry_GiveUpAfterMaxAttempts(t *testing.T) {
+	srv, attempts := newFlakyServer(t, 10, nethttp.StatusBadGateway, `{"code":0}`)
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex", WithRetryPolicy(fastRetryPolicy()))
+	if _, err := client.Request("GET", "/test", nil); err == nil {
+		t.Fatal("expected an error once attempts are exhausted")
+	}
+	if n := len(attempts()); n != 3 {
+		t.Errorf("expected 3 attempts, got %d", n)
+	}
+}
+
+func TestRetry_RateLimitCodeIsRetried(t *testing.T) {
+	srv, attempts := newFlakyServer(t, 1, nethttp.StatusOK, `{"code":100429,"msg":"too many requests"}`)
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex", WithRetryPolicy(fastRetryPolicy()))
+	if _, err := client.Request("GET", "/test", nil); err != nil {
+		t.Fatalf("expected success after retry, got %v", err)
+	}
+	if n := len(attempts()); n != 2 {
+		t.Errorf("expected 2 attempts, got %d", n)
+	}
+}
+
+func TestRetry_StaleTimestampIsRetried(t *testing.T) {
+	srv, attempts := newFlakyServer(t, 1, nethttp.StatusOK, `{"code":100421,"msg":"timestamp mismatch"}`)
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex", WithRetryPolicy(fastRetryPolicy()))
+	if _, err := client.Request("GET", "/test", nil); err != nil {
+		t.Fatalf("expected success after retry, got %v", err)
+	}
+	if n := len(attempts()); n != 2 {
+		t.Errorf("expected 2 attempts, got %d", n)
+	}
+}
+
+func TestRetry_BusinessErrorIsNotRetried(t *testing.T) {
+	srv, attempts := newFlakyServer(t, 1, nethttp.StatusOK, `{"code":80014,"msg":"invalid parameter"}`)
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex", WithRetryPolicy(fastRetryPolicy()))
+	if _, err := client.Request("GET", "/test", nil); err == nil {
+		t.Fatal("expected the business error to be returned")
+	} else if !stderrors.Is(err, errors.ErrInvalidParameter) {
+		t.Errorf("expected errors.ErrInvalidParameter, got %v", err)
+	}
+	if n := len(attempts()); n != 1 {
+		t.Errorf("expected a single attempt, got %d", n)
+	}
+}
+
+func TestRetry_POSTRequiresOptInAndClientOrderID(t *testing.T) {
+	tests := []struct {
+		name     string
+		optIn    bool
+		params   map[string]interface{}
+		attempts int
+	}{
+		{"no opt-in", false, map[string]interface{}{"clientOrderId": "abc"}, 1},
+		{"opt-in without clientOrderId", true, map[string]interface{}{"symbol": "BTC-USDT"}, 1},
+		{"opt-in with clientOrderId", true, map[string]interface{}{"clientOrderId": "abc"}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, attempts := newFlakyServer(t, 1, nethttp.StatusInternalServerError, `{}`)
+			defer srv.Close()
+
+			policy := fastRetryPolicy()
+			policy.RetryPOSTWithClientOrderID = tt.optIn
+			client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex", WithRetryPolicy(policy))
+			_, _ = client.Request("POST", "/test", tt.params)
+
+			if n := len(attempts()); n != tt.attempts {
+				t.Errorf("expected %d attempts, got %d", tt.attempts, n)
+			}
+		})
+	}
+}
+
+func TestRetry_DisabledByDefault(t *testing.T) {
+	srv, attempts := newFlakyServer(t, 1, nethttp.StatusServiceUnavailable, `{}`)
+	defer srv.Close()
+
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex")
+	if _, err := client.Request("GET", "/test", nil); err == nil {
+		t.Fatal("expected an error without a retry policy")
+	}
+	if n := len(attempts()); n != 1 {
+		t.Errorf("expected a single attempt, got %d", n)
+	}
+}
+
+func TestRetry_ContextCancelledDuringBackoff(t *testing.T) {
+	srv, attempts := newFlakyServer(t, 10, nethttp.StatusServiceUnavailable, `{}`)
+	defer srv.Close()
+
+	policy := fastRetryPolicy()
+	policy.InitialBackoff = time.Hour
+	policy.MaxBackoff = time.Hour
+	client := NewBaseHTTPClient("key", "secret", srv.URL, "", "hex", WithRetryPolicy(policy))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.RequestContext(ctx, "GET", "/test", nil); err == nil {
+		t.Fatal("expected an error")
+	}
+	if n := len(attempts()); n != 1 {
+		t.Errorf("expected backoff to be interrupted after 1 attempt, got %d", n)
+	}
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     300 * time.Millisecond,
+		Multiplier:     2,
+	}
+
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond}
+	for i, expected := range want {
+		if got := policy.delay(i+1, 0); got != expected {
+			t.Errorf("attempt %d: expected %s, got %s", i+1, expected, got)
+		}
+	}
+
+	if got := policy.delay(1, 250*time.Millisecond); got != 250*time.Millisecond {
+		t.Errorf("Retry-After should take precedence, got %s", got)
+	}
+	if got := policy.delay(1, time.Hour); got != 300*time.Millisecond {
+		t.Errorf("Retry-After should be capped at MaxBackoff, got %s", got)
+	}
+	uncapped := RetryPolicy{InitialBackoff: 100 * time.Millisecond}
+	if got := uncapped.delay(1, 2*time.Second); got != 2*time.Second {
+		t.Errorf("Retry-After without MaxBackoff = %s, want 2s", got)
+	}
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if got := policy.delay(1, 0); got < 50*time.Millisecond || got > 100*time.Millisecond {
+			t.Fatalf("jittered delay %s out of range", got)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		value    string
+		expected time.Duration
+	}{
+		{"", 0},
+		{"3", 3 * time.Second},
+		{"-1", 0},
+		{now.Add(10 * time.Second).Format(nethttp.TimeFormat), 10 * time.Second},
+		{now.Add(-10 * time.Second).Format(nethttp.TimeFormat), 0},
+		{"soon", 0},
+	}
+
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.expected {
+			t.Errorf("parseRetryAfter(%q) = %s, want %s", tt.value, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package http
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	stderrors "errors"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+const signerTestPayload = "symbol=BTC-USDT&timestamp=1702731500000"
+
+func TestHMACSigner_MatchesClientSignature(t *testing.T) {
+	signer := NewHMACSigner(testSignatureSecret, "hex")
+	got, err := signer.Sign(context.Background(), signerTestPayload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != expectedHexSignature(signerTestPayload) {
+		t.Errorf("unexpected HMAC signature %s", got)
+	}
+}
+
+func TestEd25519Signer(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := NewEd25519Signer(priv).Sign(context.Background(), signerTestPayload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(got)
+	if err != nil {
+		t.Fatalf("signature is not base64: %v", err)
+	}
+	if !ed25519.Verify(pub, []byte(signerTestPayload), sig) {
+		t.Error("Ed25519 signature does not verify")
+	}
+}
+
+func TestRSASigner(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := NewRSASigner(key).Sign(context.Background(), signerTestPayload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, _ := base64.StdEncoding.DecodeString(got)
+	digest := sha256.Sum256([]byte(signerTestPayload))
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], sig); err != nil {
+		t.Errorf("RSA signature does not verify: %v", err)
+	}
+}
+
+func TestNewCryptoSigner_RejectsUnsupportedKeys(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewCryptoSigner(key); err == nil {
+		t.Error("expected ECDSA keys to be rejected")
+	}
+
+	_, priv, _ := ed25519.GenerateKey(rand.Reader)
+	if _, err := NewCryptoSigner(priv); err != nil {
+		t.Errorf("expected Ed25519 keys to be accepted: %v", err)
+	}
+}
+
+func TestWithSigner_UsesCustomSignature(t *testing.T) {
+	var got url.Values
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got, _ = url.ParseQuery(string(body))
+		_, _ = fmt.Fprint(w, `{"code":0}`)
+	}))
+	defer srv.Close()
+
+	var signed string
+	signer := SignerFunc(func(ctx context.Context, payload string) (string, error) {
+		signed = payload
+		return "kms+signature/=", nil
+	})
+	client := NewBaseHTTPClient("key", "", srv.URL, "", "hex", WithSigner(signer))
+
+	if _, err := client.Request("POST", "/test", map[string]interface{}{"symbol": "BTC-USDT", "timestamp": "1702731500000"}); err != nil {
+		t.Fatal(err)
+	}
+	if signed != signerTestPayload {
+		t.Errorf("signer received %q", signed)
+	}
+	if got.Get("signature") != "kms+signature/=" {
+		t.Errorf("expected the custom signature on the wire, got %q", got.Get("signature"))
+	}
+}
+
+func TestWithSigner_FailureAbortsRequest(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		hits++
+	}))
+	defer srv.Close()
+
+	kmsErr := stderrors.New("kms unavailable")
+	client := NewBaseHTTPClient("key", "", srv.URL, "", "hex", WithSigner(SignerFunc(func(context.Context, string) (string, error) {
+		return "", kmsErr
+	})))
+
+	_, err := client.Request("GET", "/test", nil)
+	if !stderrors.Is(err, kmsErr) {
+		t.Fatalf("expected the signer error in the chain, got %v", err)
+	}
+	if hits != 0 {
+		t.Error("an unsigned request must not be sent")
+	}
+}
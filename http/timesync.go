@@ -0,0 +1,158 @@
+package http
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// timeSyncSmoothing is the weight of a new sample in the exponentially
+// weighted moving average of the clock offset.
+const timeSyncSmoothing = 0.3
+
+// ServerTimeSource returns the exchange's current time, for example
+// services.MarketService.FetchServerTime.
+type ServerTimeSource func(ctx context.Context) (time.Time, error)
+
+// TimeSyncStatus is a snapshot of the clock synchronization state.
+type TimeSyncStatus struct {
+	// Offset is the smoothed server-minus-local clock difference that is
+	// added to every generated request timestamp.
+	Offset time.Duration
+	// LastSample is the raw offset measured by the most recent sync.
+	LastSample time.Duration
+	// RoundTrip is the latency of the most recent successful sync.
+	RoundTrip time.Duration
+	LastSync  time.Time
+	LastError error
+	Samples   int
+}
+
+// TimeSync keeps a smoothed estimate of the offset between the local clock
+// and the BingX server clock.
+type TimeSync struct {
+	source   ServerTimeSource
+	interval time.Duration
+	now      func() time.Time
+
+	mu     sync.RWMutex
+	status TimeSyncStatus
+
+	runMu  sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewTimeSync creates a synchronizer that samples source every interval once
+// started. It does nothing until Sync or Start is called. With an interval of
+// zero or less it only syncs when Sync is called.
+func NewTimeSync(source ServerTimeSource, interval time.Duration) *TimeSync {
+	return &TimeSync{
+		source:   source,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// WithTimeSync applies the offset maintained by ts to every timestamp the
+// client generates. Caller-supplied timestamps are left untouched.
+func WithTimeSync(ts *TimeSync) Option {
+	return func(c *BaseHTTPClient) {
+		c.timeSync = ts
+	}
+}
+
+// Sync takes one sample. The server time is compared against the local time
+// halfway through the round trip.
+func (s *TimeSync) Sync(ctx context.Context) error {
+	sent := s.now()
+	serverTime, err := s.source(ctx)
+	received := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err != nil {
+		s.status.LastError = err
+		return err
+	}
+
+	rtt := received.Sub(sent)
+	sample := serverTime.Sub(sent.Add(rtt / 2))
+
+	if s.status.Samples == 0 {
+		s.status.Offset = sample
+	} else {
+		s.status.Offset += time.Duration(timeSyncSmoothing * float64(sample-s.status.Offset))
+	}
+	s.status.LastSample = sample
+	s.status.RoundTrip = rtt
+	s.status.LastSync = received
+	s.status.LastError = nil
+	s.status.Samples++
+	return nil
+}
+
+// Start syncs immediately and then every interval in the background until
+// Stop is called. Calling Start on a running synchronizer, or on one without
+// a positive interval, is a no-op.
+func (s *TimeSync) Start() {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+	if s.cancel != nil || s.interval <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+
+	go func(done chan struct{}) {
+		defer close(done)
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			_ = s.Sync(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}(s.done)
+}
+
+// Stop ends background syncing and waits for the worker to exit. The last
+// offset keeps being applied.
+func (s *TimeSync) Stop() {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+	if s.cancel == nil {
+		return
+	}
+
+	s.cancel()
+	<-s.done
+	s.cancel = nil
+	s.done = nil
+}
+
+// Offset returns the current smoothed clock offset (server minus local).
+func (s *TimeSync) Offset() time.Duration {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.status.Offset
+}
+
+// Status returns a snapshot of the synchronization state.
+func (s *TimeSync) Status() TimeSyncStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.status
+}
+
+// Now returns the local time corrected by the current offset.
+func (s *TimeSync) Now() time.Time {
+	return s.now().Add(s.Offset())
+}
@@ -0,0 +1,162 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestTimeSync_SmoothsOffset(t *testing.T) {
+	local := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	serverAhead := 2 * time.Second
+
+	ts := NewTimeSync(func(ctx context.Context) (time.Time, error) {
+		return local.Add(serverAhead), nil
+	}, time.Minute)
+	ts.now = func() time.Time { return local }
+
+	if err := ts.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if ts.Offset() != 2*time.Second {
+		t.Fatalf("first sample should set the offset, got %s", ts.Offset())
+	}
+
+	serverAhead = 3 * time.Second
+	if err := ts.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	want := 2*time.Second + time.Duration(timeSyncSmoothing*float64(time.Second))
+	if ts.Offset() != want {
+		t.Errorf("expected smoothed offset %s, got %s", want, ts.Offset())
+	}
+
+	status := ts.Status()
+	if status.Samples != 2 || status.LastSample != 3*time.Second || status.LastError != nil {
+		t.Errorf("unexpected status: %+v", status)
+	}
+	if got := ts.Now(); !got.Equal(local.Add(want)) {
+		t.Errorf("Now() = %s, want %s", got, local.Add(want))
+	}
+}
+
+func TestTimeSync_CompensatesRoundTrip(t *testing.T) {
+	local := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	calls := 0
+	ts := NewTimeSync(func(ctx context.Context) (time.Time, error) {
+		// The server stamped its reply halfway through a 100ms round trip.
+		return local.Add(50 * time.Millisecond), nil
+	}, time.Minute)
+	ts.now = func() time.Time {
+		calls++
+		if calls == 1 {
+			return local
+		}
+		return local.Add(100 * time.Millisecond)
+	}
+
+	if err := ts.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if ts.Offset() != 0 {
+		t.Errorf("expected zero offset for a perfectly synced clock, got %s", ts.Offset())
+	}
+	if ts.Status().RoundTrip != 100*time.Millisecond {
+		t.Errorf("unexpected round trip %s", ts.Status().RoundTrip)
+	}
+}
+
+func TestTimeSync_ErrorKeepsLastOffset(t *testing.T) {
+	fail := false
+	ts := NewTimeSync(func(ctx context.Context) (time.Time, error) {
+		if fail {
+			return time.Time{}, fmt.Errorf("unreachable")
+		}
+		return time.Now().Add(time.Hour), nil
+	}, time.Minute)
+
+	if err := ts.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	offset := ts.Offset()
+
+	fail = true
+	if err := ts.Sync(context.Background()); err == nil {
+		t.Fatal("expected the source error")
+	}
+	if ts.Offset() != offset {
+		t.Errorf("offset changed after a failed sync")
+	}
+	if ts.Status().LastError == nil {
+		t.Error("LastError should be recorded")
+	}
+}
+
+func TestTimeSync_StartAndStop(t *testing.T) {
+	synced := make(chan struct{}, 10)
+	ts := NewTimeSync(func(ctx context.Context) (time.Time, error) {
+		synced <- struct{}{}
+		return time.Now(), nil
+	}, 10*time.Millisecond)
+
+	ts.Start()
+	ts.Start()
+	for i := 0; i < 2; i++ {
+		select {
+		case <-synced:
+		case <-time.After(time.Second):
+			t.Fatal("background sync did not run")
+		}
+	}
+	ts.Stop()
+	ts.Stop()
+
+	if ts.Status().Samples < 2 {
+		t.Errorf("expected at least two samples, got %d", ts.Status().Samples)
+	}
+}
+
+func TestTimeSync_StartWithoutIntervalIsNoop(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		calls := 0
+		ts := NewTimeSync(func(ctx context.Context) (time.Time, error) {
+			calls++
+			return time.Now(), nil
+		}, interval)
+
+		ts.Start()
+		ts.Stop()
+		if calls != 0 {
+			t.Errorf("interval %v: Start synced %d times, want none", interval, calls)
+		}
+
+		if err := ts.Sync(context.Background()); err != nil || calls != 1 {
+			t.Errorf("interval %v: Sync() = %v after %d calls, want a manual sync", interval, err, calls)
+		}
+	}
+}
+
+func TestWithTimeSync_AppliesOffsetToTimestamp(t *testing.T) {
+	ts := NewTimeSync(func(ctx context.Context) (time.Time, error) {
+		return time.Now().Add(time.Hour), nil
+	}, time.Minute)
+	if err := ts.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	client := NewBaseHTTPClient("key", "secret", "https://api.test.com", "", "hex", WithTimeSync(ts))
+	got, err := strconv.ParseInt(client.timestamp(), 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff := time.UnixMilli(got).Sub(time.Now())
+	if diff < 59*time.Minute || diff > 61*time.Minute {
+		t.Errorf("expected timestamp about one hour ahead, got %s", diff)
+	}
+	if client.TimeSync() != ts {
+		t.Error("TimeSync() should return the configured synchronizer")
+	}
+}
@@ -0,0 +1,61 @@
+package bingx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tigusigalpa/bingx-go/v2/services"
+	"github.com/tigusigalpa/bingx-go/v2/services/coinm"
+	"github.com/tigusigalpa/bingx-go/v2/services/tradfi"
+	"github.com/tigusigalpa/bingx-go/v2/websocket"
+)
+
+type listenKeyFuncs struct {
+	create func(ctx context.Context) (map[string]interface{}, error)
+	extend func(ctx context.Context, listenKey string) (map[string]interface{}, error)
+	delete func(ctx context.Context, listenKey string) (map[string]interface{}, error)
+}
+
+// USDTMListenKeys manages listen keys with the USDT-M listen key service,
+// e.g. client.ListenKey(), for websocket.NewManagedAccountStream.
+func USDTMListenKeys(s *services.ListenKeyService) websocket.ListenKeySource {
+	return listenKeyFuncs{create: s.GenerateContext, extend: s.ExtendContext, delete: s.DeleteContext}
+}
+
+// CoinMListenKeys manages listen keys with the coin-M listen key service,
+// e.g. client.CoinM().ListenKey().
+func CoinMListenKeys(s *coinm.ListenKeyService) websocket.ListenKeySource {
+	return listenKeyFuncs{create: s.GenerateContext, extend: s.ExtendContext, delete: s.DeleteContext}
+}
+
+// TradFiListenKeys manages listen keys with the TradFi listen key service,
+// e.g. client.TradFi().ListenKey().
+func TradFiListenKeys(s *tradfi.ListenKeyService) websocket.ListenKeySource {
+	return listenKeyFuncs{create: s.CreateContext, extend: s.ExtendContext, delete: s.DeleteContext}
+}
+
+func (f listenKeyFuncs) Create(ctx context.Context) (string, error) {
+	resp, err := f.create(ctx)
+	if err != nil {
+		return "", err
+	}
+	if listenKey, ok := resp["listenKey"].(string); ok && listenKey != "" {
+		return listenKey, nil
+	}
+	if data, ok := resp["data"].(map[string]interface{}); ok {
+		if listenKey, ok := data["listenKey"].(string); ok && listenKey != "" {
+			return listenKey, nil
+		}
+	}
+	return "", fmt.Errorf("listen key missing from response")
+}
+
+func (f listenKeyFuncs) Extend(ctx context.Context, listenKey string) error {
+	_, err := f.extend(ctx, listenKey)
+	return err
+}
+
+func (f listenKeyFuncs) Delete(ctx context.Context, listenKey string) error {
+	_, err := f.delete(ctx, listenKey)
+	return err
+}
@@ -0,0 +1,63 @@
+package bingx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	bxhttp "github.com/tigusigalpa/bingx-go/v2/http"
+	"github.com/tigusigalpa/bingx-go/v2/services"
+	"github.com/tigusigalpa/bingx-go/v2/services/coinm"
+	"github.com/tigusigalpa/bingx-go/v2/services/tradfi"
+	"github.com/tigusigalpa/bingx-go/v2/websocket"
+)
+
+func TestListenKeyAdapters(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/openApi/swap/v2/user/listenKey":
+			_, _ = w.Write([]byte(`{"code":0,"msg":"","data":{"listenKey":"tradfi-key"}}`))
+		default:
+			_, _ = w.Write([]byte(`{"listenKey":"a8ea75681542e66f1a50a1616dd06ed77dab61baa0c296bca03a9b13ee5f2dd7"}`))
+		}
+	}))
+	defer srv.Close()
+
+	client := bxhttp.NewBaseHTTPClient("key", "secret", srv.URL, "", "base64")
+	tests := []struct {
+		name string
+		keys websocket.ListenKeySource
+		want string
+	}{
+		{"USDT-M", USDTMListenKeys(services.NewListenKeyService(client)), "a8ea75681542e66f1a50a1616dd06ed77dab61baa0c296bca03a9b13ee5f2dd7"},
+		{"coin-M", CoinMListenKeys(coinm.NewListenKeyService(client)), "a8ea75681542e66f1a50a1616dd06ed77dab61baa0c296bca03a9b13ee5f2dd7"},
+		{"TradFi", TradFiListenKeys(tradfi.NewListenKeyService(client)), "tradfi-key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = nil
+			ctx := context.Background()
+			got, err := tt.keys.Create(ctx)
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Create() = %q, want %q", got, tt.want)
+			}
+			if err := tt.keys.Extend(ctx, got); err != nil {
+				t.Errorf("Extend() error = %v", err)
+			}
+			if err := tt.keys.Delete(ctx, got); err != nil {
+				t.Errorf("Delete() error = %v", err)
+			}
+			if len(requests) != 3 || requests[0][:4] != "POST" || requests[1][:3] != "PUT" || requests[2][:6] != "DELETE" {
+				t.Errorf("requests = %v, want POST, PUT, DELETE", requests)
+			}
+		})
+	}
+}
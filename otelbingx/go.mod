@@ -0,0 +1,23 @@
+// otelbingx is tagged as otelbingx/v2.x.y, after the root module release it
+// requires below. go.work builds it against the parent directory.
+module github.com/tigusigalpa/bingx-go/otelbingx/v2
+
+go 1.21
+
+require (
+	github.com/gorilla/websocket v1.5.1
+	github.com/tigusigalpa/bingx-go/v2 v2.4.0
+	go.opentelemetry.io/otel v1.28.0
+	go.opentelemetry.io/otel/metric v1.28.0
+	go.opentelemetry.io/otel/sdk v1.28.0
+	go.opentelemetry.io/otel/sdk/metric v1.28.0
+	go.opentelemetry.io/otel/trace v1.28.0
+)
+
+require (
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	golang.org/x/net v0.17.0 // indirect
+	golang.org/x/sys v0.21.0 // indirect
+)
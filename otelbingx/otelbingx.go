@@ -0,0 +1,96 @@
+// Package otelbingx instruments the BingX client with OpenTelemetry traces and
+// metrics. It is a separate module, so the core module does not depend on
+// OpenTelemetry.
+//
+// REST calls are traced through an http.Interceptor:
+//
+//	interceptor, err := otelbingx.NewInterceptor()
+//	client := bingx.NewClient(key, secret, bingx.WithInterceptors(interceptor))
+//
+// WebSocket sessions are traced with InstrumentWebSocket:
+//
+//	stream := client.NewMarketDataStream()
+//	err := otelbingx.InstrumentWebSocket(stream.WebSocketClient)
+package otelbingx
+
+import (
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope used for the tracer and meter.
+const ScopeName = "github.com/tigusigalpa/bingx-go/otelbingx/v2"
+
+// Attribute keys set on spans and metrics.
+const (
+	AttrMethod     = attribute.Key("http.request.method")
+	AttrPath       = attribute.Key("url.path")
+	AttrStatusCode = attribute.Key("http.response.status_code")
+	AttrCode       = attribute.Key("bingx.code")
+	AttrRetryCount = attribute.Key("bingx.retry_count")
+	AttrErrorType  = attribute.Key("error.type")
+	AttrURL        = attribute.Key("url.full")
+	AttrReconnect  = attribute.Key("bingx.ws.reconnect")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+// WithTracerProvider sets the TracerProvider. The global provider is used by
+// default.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the MeterProvider. The global provider is used by
+// default.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+func newConfig(opts []Option) config {
+	c := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// recordError marks span as failed with an exception event. span.RecordError
+// is avoided because it exports err.Error() as is, which may quote a signed
+// URL or a listenKey; message must already be redacted.
+func recordError(span trace.Span, kind, message string) {
+	span.AddEvent("exception", trace.WithAttributes(
+		attribute.String("exception.type", kind),
+		attribute.String("exception.message", message),
+	))
+	span.SetStatus(codes.Error, message)
+}
+
+// errorType names the concrete type of err without its package, e.g.
+// "RateLimitException" or "APIException".
+func errorType(err error) string {
+	name := strings.TrimPrefix(fmt.Sprintf("%T", err), "*")
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
@@ -0,0 +1,264 @@
+package otelbingx
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gorilla "github.com/gorilla/websocket"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/tigusigalpa/bingx-go/v2/errors"
+	"github.com/tigusigalpa/bingx-go/v2/http"
+	"github.com/tigusigalpa/bingx-go/v2/websocket"
+)
+
+func newTestProviders() (*tracetest.InMemoryExporter, *sdktrace.TracerProvider, *sdkmetric.ManualReader, *sdkmetric.MeterProvider) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	return exporter, tp, reader, mp
+}
+
+func collect(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Aggregation {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	out := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			out[m.Name] = m.Data
+		}
+	}
+	return out
+}
+
+func sumOf(t *testing.T, data metricdata.Aggregation) int64 {
+	t.Helper()
+	sum, ok := data.(metricdata.Sum[int64])
+	if !ok {
+		t.Fatalf("expected an int64 sum, got %T", data)
+	}
+	var total int64
+	for _, dp := range sum.DataPoints {
+		total += dp.Value
+	}
+	return total
+}
+
+func spanAttr(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestInterceptor_TracesAndMeasuresRESTCalls(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			w.WriteHeader(nethttp.StatusServiceUnavailable)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"code":100429,"msg":"too many requests"}`)
+	}))
+	defer srv.Close()
+
+	exporter, tp, reader, mp := newTestProviders()
+	interceptor, err := NewInterceptor(WithTracerProvider(tp), WithMeterProvider(mp))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := http.NewBaseHTTPClient("key", "secret", srv.URL, "", "hex",
+		http.WithRetryPolicy(http.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}),
+		http.WithInterceptors(interceptor),
+	)
+	_, err = client.Request("GET", "/openApi/swap/v2/quote/depth", nil)
+	if _, ok := err.(*errors.RateLimitException); !ok {
+		t.Fatalf("expected a rate limit error, got %v", err)
+	}
+
+	spans := exporter.GetSpans().Snapshots()
+	if len(spans) != 1 {
+		t.Fatalf("expected one span per call, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "GET /openApi/swap/v2/quote/depth" {
+		t.Errorf("unexpected span name %q", span.Name())
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("expected error status, got %v", span.Status())
+	}
+	if v, _ := spanAttr(span, AttrRetryCount); v.AsInt64() != 1 {
+		t.Errorf("expected retry count 1, got %v", v.AsInt64())
+	}
+	if v, _ := spanAttr(span, AttrCode); v.AsString() != "100429" {
+		t.Errorf("expected BingX code 100429, got %q", v.AsString())
+	}
+	if v, _ := spanAttr(span, AttrErrorType); v.AsString() != "RateLimitException" {
+		t.Errorf("expected error type RateLimitException, got %q", v.AsString())
+	}
+
+	metrics := collect(t, reader)
+	if got := sumOf(t, metrics["bingx.rest.requests"]); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+	if got := sumOf(t, metrics["bingx.rest.errors"]); got != 1 {
+		t.Errorf("expected 1 error, got %d", got)
+	}
+	if got := sumOf(t, metrics["bingx.rest.retries"]); got != 1 {
+		t.Errorf("expected 1 retry, got %d", got)
+	}
+	hist, ok := metrics["bingx.rest.duration"].(metricdata.Histogram[float64])
+	if !ok || len(hist.DataPoints) != 1 || hist.DataPoints[0].Count != 1 {
+		t.Errorf("expected one duration sample, got %+v", metrics["bingx.rest.duration"])
+	}
+}
+
+func TestInterceptor_RedactsErrorText(t *testing.T) {
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		conn, _, err := w.(nethttp.Hijacker).Hijack()
+		if err == nil {
+			_ = conn.Close()
+		}
+	}))
+	defer srv.Close()
+
+	exporter, tp, _, mp := newTestProviders()
+	interceptor, err := NewInterceptor(WithTracerProvider(tp), WithMeterProvider(mp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := http.NewBaseHTTPClient("key", "secret", srv.URL, "", "hex", http.WithInterceptors(interceptor))
+	if _, err := client.Request("GET", "/openApi/user/auth/userDataStream", map[string]interface{}{"listenKey": "lk-123"}); err == nil {
+		t.Fatal("expected a transport error")
+	}
+
+	spans := exporter.GetSpans().Snapshots()
+	if len(spans) != 1 {
+		t.Fatalf("expected one span, got %d", len(spans))
+	}
+	span := spans[0]
+	exported := span.Status().Description
+	for _, event := range span.Events() {
+		for _, kv := range event.Attributes {
+			exported += " " + kv.Value.Emit()
+		}
+	}
+	if !strings.Contains(exported, "signature="+http.Redacted) {
+		t.Errorf("expected the redacted URL in %q", exported)
+	}
+	for _, secret := range []string{"lk-123", "secret"} {
+		if strings.Contains(exported, secret) {
+			t.Errorf("span exports %q: %s", secret, exported)
+		}
+	}
+}
+
+func TestInstrumentWebSocket_RedactsCloseError(t *testing.T) {
+	upgrader := gorilla.Upgrader{}
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		_ = conn.WriteMessage(gorilla.CloseMessage, gorilla.FormatCloseMessage(gorilla.ClosePolicyViolation, "listenKey=lk-123 expired"))
+	}))
+	defer srv.Close()
+
+	exporter, tp, _, mp := newTestProviders()
+	client := websocket.NewWebSocketClient("ws" + strings.TrimPrefix(srv.URL, "http"))
+	if err := InstrumentWebSocket(client, WithTracerProvider(tp), WithMeterProvider(mp)); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Listen(); err == nil || !strings.Contains(err.Error(), "lk-123") {
+		t.Fatalf("Listen() = %v, want the close reason", err)
+	}
+	_ = client.Disconnect()
+
+	spans := exporter.GetSpans().Snapshots()
+	if len(spans) != 1 {
+		t.Fatalf("expected one span, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Status().Code != codes.Error {
+		t.Errorf("expected error status, got %v", span.Status())
+	}
+	exported := span.Status().Description
+	for _, event := range span.Events() {
+		for _, kv := range event.Attributes {
+			exported += " " + kv.Value.Emit()
+		}
+	}
+	if strings.Contains(exported, "lk-123") || !strings.Contains(exported, "listenKey="+http.Redacted) {
+		t.Errorf("span exports %q, want the listenKey redacted", exported)
+	}
+}
+
+func TestInstrumentWebSocket_TracesSessions(t *testing.T) {
+	upgrader := gorilla.Upgrader{}
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		_ = conn.WriteMessage(gorilla.TextMessage, []byte(`{"dataType":"BTC-USDT@trade"}`))
+		_ = conn.WriteMessage(gorilla.TextMessage, []byte(`{broken`))
+		_ = conn.WriteMessage(gorilla.CloseMessage, gorilla.FormatCloseMessage(gorilla.CloseNormalClosure, ""))
+	}))
+	defer srv.Close()
+
+	exporter, tp, reader, mp := newTestProviders()
+	client := websocket.NewWebSocketClient("ws" + strings.TrimPrefix(srv.URL, "http"))
+	if err := InstrumentWebSocket(client, WithTracerProvider(tp), WithMeterProvider(mp)); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := client.Connect(); err != nil {
+			t.Fatal(err)
+		}
+		_ = client.Listen()
+		_ = client.Disconnect()
+	}
+
+	spans := exporter.GetSpans().Snapshots()
+	if len(spans) != 2 {
+		t.Fatalf("expected one span per session, got %d", len(spans))
+	}
+	if v, _ := spanAttr(spans[1], AttrReconnect); !v.AsBool() {
+		t.Error("second session should be marked as a reconnect")
+	}
+
+	metrics := collect(t, reader)
+	if got := sumOf(t, metrics["bingx.ws.reconnects"]); got != 1 {
+		t.Errorf("expected 1 reconnect, got %d", got)
+	}
+	if got := sumOf(t, metrics["bingx.ws.messages"]); got != 2 {
+		t.Errorf("expected 2 messages, got %d", got)
+	}
+	if got := sumOf(t, metrics["bingx.ws.dropped"]); got != 2 {
+		t.Errorf("expected 2 dropped frames, got %d", got)
+	}
+}
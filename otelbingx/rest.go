@@ -0,0 +1,117 @@
+package otelbingx
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tigusigalpa/bingx-go/v2/http"
+)
+
+type callStartKey struct{}
+
+type restInstruments struct {
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	requests metric.Int64Counter
+	errors   metric.Int64Counter
+	retries  metric.Int64Counter
+}
+
+// NewInterceptor returns an http.Interceptor that creates one client span per
+// REST call and records these metrics:
+//
+//   - bingx.rest.duration: call latency in seconds, including retries
+//   - bingx.rest.requests: completed calls
+//   - bingx.rest.errors: failed calls by error.type
+//   - bingx.rest.retries: retry attempts
+func NewInterceptor(opts ...Option) (http.Interceptor, error) {
+	cfg := newConfig(opts)
+	meter := cfg.meterProvider.Meter(ScopeName)
+
+	inst := &restInstruments{tracer: cfg.tracerProvider.Tracer(ScopeName)}
+	var err error
+	if inst.duration, err = meter.Float64Histogram("bingx.rest.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of BingX REST calls, including retries.")); err != nil {
+		return http.Interceptor{}, err
+	}
+	if inst.requests, err = meter.Int64Counter("bingx.rest.requests",
+		metric.WithDescription("Completed BingX REST calls.")); err != nil {
+		return http.Interceptor{}, err
+	}
+	if inst.errors, err = meter.Int64Counter("bingx.rest.errors",
+		metric.WithDescription("Failed BingX REST calls by error type.")); err != nil {
+		return http.Interceptor{}, err
+	}
+	if inst.retries, err = meter.Int64Counter("bingx.rest.retries",
+		metric.WithDescription("Retried BingX REST attempts.")); err != nil {
+		return http.Interceptor{}, err
+	}
+
+	return http.Interceptor{
+		BeforeCall:    inst.beforeCall,
+		AfterResponse: inst.afterResponse,
+		AfterCall:     inst.afterCall,
+	}, nil
+}
+
+func (i *restInstruments) beforeCall(ctx context.Context, method, path string) context.Context {
+	ctx, _ = i.tracer.Start(ctx, method+" "+path,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(AttrMethod.String(method), AttrPath.String(path)),
+	)
+	return context.WithValue(ctx, callStartKey{}, time.Now())
+}
+
+func (i *restInstruments) afterResponse(ctx context.Context, resp http.ResponseInfo) {
+	if !resp.WillRetry {
+		return
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.Int("bingx.attempt", resp.Request.Attempt),
+		AttrStatusCode.Int(resp.StatusCode),
+		AttrCode.String(resp.Code),
+	}
+	if resp.Err != nil {
+		attrs = append(attrs, AttrErrorType.String(errorType(resp.Err)))
+	}
+	trace.SpanFromContext(ctx).AddEvent("retry", trace.WithAttributes(attrs...))
+	i.retries.Add(ctx, 1, metric.WithAttributes(
+		AttrMethod.String(resp.Request.Method),
+		AttrPath.String(resp.Request.Path),
+	))
+}
+
+func (i *restInstruments) afterCall(ctx context.Context, resp http.ResponseInfo) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	common := []attribute.KeyValue{
+		AttrMethod.String(resp.Request.Method),
+		AttrPath.String(resp.Request.Path),
+		AttrCode.String(resp.Code),
+	}
+
+	span.SetAttributes(common...)
+	span.SetAttributes(AttrRetryCount.Int(resp.Request.Attempt - 1))
+	if resp.StatusCode > 0 {
+		span.SetAttributes(AttrStatusCode.Int(resp.StatusCode))
+	}
+
+	if start, ok := ctx.Value(callStartKey{}).(time.Time); ok {
+		i.duration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(common...))
+	}
+	i.requests.Add(ctx, 1, metric.WithAttributes(common...))
+
+	if resp.Err != nil {
+		kind := errorType(resp.Err)
+		recordError(span, kind, resp.ErrMessage)
+		span.SetAttributes(AttrErrorType.String(kind))
+		i.errors.Add(ctx, 1, metric.WithAttributes(append(common, AttrErrorType.String(kind))...))
+	}
+}
@@ -0,0 +1,93 @@
+package otelbingx
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tigusigalpa/bingx-go/v2/http"
+	"github.com/tigusigalpa/bingx-go/v2/websocket"
+)
+
+// InstrumentWebSocket registers hooks on client that create one span per
+// connected session and record these metrics:
+//
+//   - bingx.ws.connects: dial attempts by outcome
+//   - bingx.ws.reconnects: successful connections after the first one
+//   - bingx.ws.messages: messages delivered to callbacks
+//   - bingx.ws.dropped: frames that could not be decoded
+//
+// Streams embed the client, e.g. InstrumentWebSocket(stream.WebSocketClient).
+func InstrumentWebSocket(client *websocket.WebSocketClient, opts ...Option) error {
+	cfg := newConfig(opts)
+	meter := cfg.meterProvider.Meter(ScopeName)
+	tracer := cfg.tracerProvider.Tracer(ScopeName)
+
+	connects, err := meter.Int64Counter("bingx.ws.connects",
+		metric.WithDescription("BingX WebSocket dial attempts."))
+	if err != nil {
+		return err
+	}
+	reconnects, err := meter.Int64Counter("bingx.ws.reconnects",
+		metric.WithDescription("BingX WebSocket reconnections."))
+	if err != nil {
+		return err
+	}
+	messages, err := meter.Int64Counter("bingx.ws.messages",
+		metric.WithDescription("BingX WebSocket messages received."))
+	if err != nil {
+		return err
+	}
+	dropped, err := meter.Int64Counter("bingx.ws.dropped",
+		metric.WithDescription("BingX WebSocket frames dropped because they could not be decoded."))
+	if err != nil {
+		return err
+	}
+
+	var mu sync.Mutex
+	var session trace.Span
+	ctx := context.Background()
+
+	client.AddHooks(websocket.Hooks{
+		OnConnect: func(url string, reconnect bool, err error) {
+			if err != nil {
+				connects.Add(ctx, 1, metric.WithAttributes(AttrURL.String(url), AttrErrorType.String(errorType(err))))
+				return
+			}
+			connects.Add(ctx, 1, metric.WithAttributes(AttrURL.String(url)))
+			if reconnect {
+				reconnects.Add(ctx, 1, metric.WithAttributes(AttrURL.String(url)))
+			}
+
+			_, span := tracer.Start(ctx, "bingx.websocket.session",
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(AttrURL.String(url), AttrReconnect.Bool(reconnect)),
+			)
+			mu.Lock()
+			session = span
+			mu.Unlock()
+		},
+		OnClose: func(url string, err error) {
+			mu.Lock()
+			span := session
+			session = nil
+			mu.Unlock()
+			if span == nil {
+				return
+			}
+			if err != nil {
+				recordError(span, errorType(err), http.RedactQuery(err.Error()))
+			}
+			span.End()
+		},
+		OnMessage: func(url string, data map[string]interface{}) {
+			messages.Add(ctx, 1, metric.WithAttributes(AttrURL.String(url)))
+		},
+		OnDrop: func(url string, err error) {
+			dropped.Add(ctx, 1, metric.WithAttributes(AttrURL.String(url)))
+		},
+	})
+	return nil
+}
@@ -0,0 +1,105 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"regexp"
+	"sync"
+
+	"github.com/tigusigalpa/bingx-go/v2/http"
+)
+
+// sensitiveBodyFields matches JSON string fields in response bodies that
+// must not be written to disk, such as the listenKey returned by
+// ListenKey().Generate().
+var sensitiveBodyFields = regexp.MustCompile(`"(listenKey|apiKey|apiSecret|secretKey)"(\s*):(\s*)"[^"]*"`)
+
+// Recorder is an http.RoundTripper that forwards requests to the exchange
+// and records every exchange of request and response.
+type Recorder struct {
+	next nethttp.RoundTripper
+
+	// ScrubBody, when set, rewrites each response body before it is
+	// recorded, after the built-in scrubbing of key fields. The live caller
+	// still receives the original body.
+	ScrubBody func(path string, body []byte) []byte
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder records the traffic sent through next, or through
+// http.DefaultTransport when next is nil.
+func NewRecorder(next nethttp.RoundTripper) *Recorder {
+	if next == nil {
+		next = nethttp.DefaultTransport
+	}
+	return &Recorder{next: next}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	recorded, err := requestOf(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	stored := sensitiveBodyFields.ReplaceAll(body, []byte(`"$1"$2:$3"`+http.Redacted+`"`))
+	if r.ScrubBody != nil {
+		stored = r.ScrubBody(recorded.Path, stored)
+	}
+
+	response := Response{StatusCode: resp.StatusCode, Header: recordedHeader(resp.Header)}
+	if json.Valid(stored) {
+		response.Body = json.RawMessage(stored)
+	} else {
+		response.Text = string(stored)
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{Request: recorded, Response: response})
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// Cassette returns a copy of the interactions recorded so far.
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &Cassette{Interactions: append([]Interaction(nil), r.cassette.Interactions...)}
+}
+
+// Save writes the interactions recorded so far to path.
+func (r *Recorder) Save(path string) error {
+	return r.Cassette().Save(path)
+}
+
+// recordedHeader keeps the response headers that affect the client, such as
+// Content-Type and Retry-After. Cookies are dropped, and so is Content-Length
+// because stored bodies are scrubbed and re-indented.
+func recordedHeader(header nethttp.Header) map[string][]string {
+	kept := map[string][]string{}
+	for k, v := range header {
+		if k == "Set-Cookie" || k == "Date" || k == "Content-Length" {
+			continue
+		}
+		kept[k] = append([]string(nil), v...)
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+	return kept
+}
@@ -0,0 +1,178 @@
+package replay
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	bxerrors "github.com/tigusigalpa/bingx-go/v2/errors"
+	bxhttp "github.com/tigusigalpa/bingx-go/v2/http"
+)
+
+func newExchange(t *testing.T) *httptest.Server {
+	t.Helper()
+	var polls int32
+	return httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=live")
+		switch r.URL.Path {
+		case "/openApi/swap/v2/trade/openOrders":
+			n := atomic.AddInt32(&polls, 1)
+			_, _ = fmt.Fprintf(w, `{"code":0,"msg":"","data":{"orders":[{"orderId":1736012345678901234,"status":"NEW","poll":%d}]}}`, n)
+		case "/openApi/user/auth/userDataStream":
+			if r.Method == nethttp.MethodPost {
+				_, _ = fmt.Fprint(w, `{"listenKey":"live-listen-key"}`)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{}`)
+		case "/openApi/swap/v2/trade/order":
+			_ = r.ParseForm()
+			_, _ = fmt.Fprintf(w, `{"code":0,"msg":"","data":{"order":{"symbol":%q,"side":%q}}}`, r.PostForm.Get("symbol"), r.PostForm.Get("side"))
+		default:
+			nethttp.NotFound(w, r)
+		}
+	}))
+}
+
+func record(t *testing.T, path string) {
+	t.Helper()
+	srv := newExchange(t)
+	defer srv.Close()
+
+	rec := NewRecorder(nil)
+	client := bxhttp.NewBaseHTTPClient("live-key", "live-secret", srv.URL, "", "hex", bxhttp.WithTransport(rec))
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/openOrders", map[string]interface{}{"symbol": "BTC-USDT"}); err != nil {
+			t.Fatalf("record openOrders: %v", err)
+		}
+	}
+	if _, err := client.RequestContext(ctx, "POST", "/openApi/user/auth/userDataStream", nil); err != nil {
+		t.Fatalf("record listenKey: %v", err)
+	}
+	if _, err := client.RequestContext(ctx, "PUT", "/openApi/user/auth/userDataStream", map[string]interface{}{"listenKey": "live-listen-key"}); err != nil {
+		t.Fatalf("record extend: %v", err)
+	}
+	if _, err := client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/order", map[string]interface{}{"symbol": "BTC-USDT", "side": "BUY"}); err != nil {
+		t.Fatalf("record order: %v", err)
+	}
+	if err := rec.Save(path); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+}
+
+func TestRecorder_ScrubsSecrets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fixtures", "session.json")
+	record(t, path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"live-key", "live-secret", "live-listen-key", "signature", "timestamp", "session=live"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, data)
+		}
+	}
+
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cassette.Interactions) != 5 {
+		t.Fatalf("expected 5 interactions, got %d", len(cassette.Interactions))
+	}
+	if got := cassette.Interactions[4].Request.Params; got["symbol"] != "BTC-USDT" || got["side"] != "BUY" {
+		t.Errorf("POST body params not recorded: %v", got)
+	}
+}
+
+func TestReplayer_ReplaysRecordedSession(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.json")
+	record(t, path)
+
+	player, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := bxhttp.NewBaseHTTPClient("other-key", "other-secret", "https://open-api.bingx.com", "", "hex", bxhttp.WithTransport(player), bxhttp.WithJSONNumbers())
+	ctx := context.Background()
+
+	polls := make([]string, 0, 3)
+	for i := 0; i < 3; i++ {
+		resp, err := client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/openOrders", map[string]interface{}{"symbol": "BTC-USDT"})
+		if err != nil {
+			t.Fatalf("replay openOrders: %v", err)
+		}
+		order := resp["data"].(map[string]interface{})["orders"].([]interface{})[0].(map[string]interface{})
+		if fmt.Sprint(order["orderId"]) != "1736012345678901234" {
+			t.Errorf("unexpected orderId %v", order["orderId"])
+		}
+		polls = append(polls, fmt.Sprint(order["poll"]))
+	}
+	if strings.Join(polls, ",") != "1,2,2" {
+		t.Errorf("expected recorded order then a repeat of the last response, got %v", polls)
+	}
+
+	if _, err := client.RequestContext(ctx, "PUT", "/openApi/user/auth/userDataStream", map[string]interface{}{"listenKey": "replayed-key"}); err != nil {
+		t.Errorf("a redacted listenKey should match any value: %v", err)
+	}
+	if _, err := client.RequestContext(ctx, "POST", "/openApi/user/auth/userDataStream", nil); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/order", map[string]interface{}{"side": "BUY", "symbol": "BTC-USDT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order := resp["data"].(map[string]interface{})["order"].(map[string]interface{}); order["symbol"] != "BTC-USDT" {
+		t.Errorf("unexpected order %v", order)
+	}
+	if unused := player.Unused(); len(unused) != 0 {
+		t.Errorf("expected every interaction to be replayed, %d left", len(unused))
+	}
+}
+
+func TestReplayer_UnmatchedRequestFails(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.json")
+	record(t, path)
+
+	player, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := bxhttp.NewBaseHTTPClient("key", "secret", "https://open-api.bingx.com", "", "hex", bxhttp.WithTransport(player))
+
+	_, err = client.RequestContext(context.Background(), "GET", "/openApi/swap/v2/trade/openOrders", map[string]interface{}{"symbol": "ETH-USDT"})
+	var mismatch *MismatchError
+	if !stderrors.As(err, &mismatch) {
+		t.Fatalf("expected a MismatchError, got %v", err)
+	}
+	if want := "GET /openApi/swap/v2/trade/openOrders?symbol=ETH-USDT"; mismatch.Request.String() != want {
+		t.Errorf("mismatch request = %q, want %q", mismatch.Request.String(), want)
+	}
+	if len(player.Unused()) != 5 {
+		t.Error("a failed match must not consume interactions")
+	}
+}
+
+func TestReplayer_NonJSONBody(t *testing.T) {
+	player := NewReplayer(&Cassette{Interactions: []Interaction{{
+		Request:  Request{Method: "GET", Path: "/openApi/swap/v2/quote/price"},
+		Response: Response{StatusCode: nethttp.StatusBadGateway, Text: "<html>bad gateway</html>"},
+	}}})
+	client := bxhttp.NewBaseHTTPClient("", "", "https://open-api.bingx.com", "", "hex", bxhttp.WithTransport(player))
+
+	_, err := client.PublicRequestContext(context.Background(), "GET", "/openApi/swap/v2/quote/price", nil)
+	ex, ok := bxerrors.AsBingXException(err)
+	if !ok || ex.StatusCode != nethttp.StatusBadGateway || string(ex.Body) != "<html>bad gateway</html>" {
+		t.Errorf("expected a 502 error, got %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package replay
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strings"
+	"sync"
+)
+
+// Replayer is an http.RoundTripper that answers requests from a Cassette
+// without touching the network.
+//
+// Interactions that match the same request are returned in recorded order;
+// once they are used up the last one keeps being returned, so polling loops
+// see a stable final state. A request without any recorded match fails with
+// a *MismatchError.
+type Replayer struct {
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// MismatchError reports a request that the cassette has no answer for.
+type MismatchError struct {
+	Request Request
+}
+
+func (e *MismatchError) Error() string {
+	return "replay: no recorded interaction for " + e.Request.String()
+}
+
+// NewReplayer replays the interactions of cassette.
+func NewReplayer(cassette *Cassette) *Replayer {
+	return &Replayer{
+		interactions: cassette.Interactions,
+		used:         make([]bool, len(cassette.Interactions)),
+	}
+}
+
+// Load reads the cassette at path and returns a Replayer for it.
+func Load(path string) (*Replayer, error) {
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewReplayer(cassette), nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Replayer) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	live, err := requestOf(req)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction, ok := r.next(live)
+	if !ok {
+		return nil, &MismatchError{Request: live}
+	}
+	return response(req, interaction.Response), nil
+}
+
+// Unused returns the recorded interactions that were never replayed, so
+// tests can assert that a strategy made every expected call.
+func (r *Replayer) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []Interaction
+	for i, interaction := range r.interactions {
+		if !r.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+	return unused
+}
+
+func (r *Replayer) next(live Request) (Interaction, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	last := -1
+	for i, interaction := range r.interactions {
+		if !interaction.Request.matches(live) {
+			continue
+		}
+		if !r.used[i] {
+			r.used[i] = true
+			return interaction, true
+		}
+		last = i
+	}
+	if last < 0 {
+		return Interaction{}, false
+	}
+	return r.interactions[last], true
+}
+
+func response(req *nethttp.Request, recorded Response) *nethttp.Response {
+	body := []byte(recorded.Body)
+	if len(body) == 0 {
+		body = []byte(recorded.Text)
+	}
+
+	header := nethttp.Header{}
+	for k, v := range recorded.Header {
+		header[k] = append([]string(nil), v...)
+	}
+
+	status := recorded.StatusCode
+	if status == 0 {
+		status = nethttp.StatusOK
+	}
+	return &nethttp.Response{
+		Status:        strings.TrimSpace(fmt.Sprintf("%d %s", status, nethttp.StatusText(status))),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
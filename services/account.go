@@ -1,7 +1,7 @@
 package services
 
 import (
-	"time"
+	"context"
 
 	"github.com/tigusigalpa/bingx-go/v2/http"
 )
@@ -15,77 +15,115 @@ func NewAccountService(client *http.BaseHTTPClient) *AccountService {
 }
 
 func (s *AccountService) GetBalance() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v3/user/balance", nil)
+	return s.GetBalanceContext(context.Background())
+}
+
+func (s *AccountService) GetBalanceContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v3/user/balance", nil)
 }
 
 func (s *AccountService) GetPositions(symbol *string) (map[string]interface{}, error) {
+	return s.GetPositionsContext(context.Background(), symbol)
+}
+
+func (s *AccountService) GetPositionsContext(ctx context.Context, symbol *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 	if symbol != nil {
 		params["symbol"] = *symbol
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/user/positions", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/positions", params)
 }
 
 func (s *AccountService) GetAccountInfo() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/account", nil)
+	return s.GetAccountInfoContext(context.Background())
+}
+
+func (s *AccountService) GetAccountInfoContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/account", nil)
 }
 
 func (s *AccountService) GetTradingFees(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/tradingFees", map[string]interface{}{
+	return s.GetTradingFeesContext(context.Background(), symbol)
+}
+
+func (s *AccountService) GetTradingFeesContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/tradingFees", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *AccountService) GetMarginMode(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/getMarginMode", map[string]interface{}{
+	return s.GetMarginModeContext(context.Background(), symbol)
+}
+
+func (s *AccountService) GetMarginModeContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/getMarginMode", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *AccountService) SetMarginMode(symbol, marginMode string) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/user/setMarginMode", map[string]interface{}{
+	return s.SetMarginModeContext(context.Background(), symbol, marginMode)
+}
+
+func (s *AccountService) SetMarginModeContext(ctx context.Context, symbol, marginMode string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/user/setMarginMode", map[string]interface{}{
 		"symbol":     symbol,
 		"marginMode": marginMode,
 	})
 }
 
 func (s *AccountService) GetLeverage(symbol string, recvWindow *int) (map[string]interface{}, error) {
+	return s.GetLeverageContext(context.Background(), symbol, recvWindow)
+}
+
+func (s *AccountService) GetLeverageContext(ctx context.Context, symbol string, recvWindow *int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
-		"symbol":    symbol,
-		"timestamp": time.Now().UnixMilli(),
+		"symbol": symbol,
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/leverage", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/leverage", params)
 }
 
 func (s *AccountService) SetLeverage(symbol, side string, leverage int, recvWindow *int) (map[string]interface{}, error) {
+	return s.SetLeverageContext(context.Background(), symbol, side, leverage, recvWindow)
+}
+
+func (s *AccountService) SetLeverageContext(ctx context.Context, symbol, side string, leverage int, recvWindow *int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
-		"symbol":    symbol,
-		"side":      side,
-		"leverage":  leverage,
-		"timestamp": time.Now().UnixMilli(),
+		"symbol":   symbol,
+		"side":     side,
+		"leverage": leverage,
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("POST", "/openApi/swap/v2/trade/leverage", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/leverage", params)
 }
 
 func (s *AccountService) GetPositionMargin(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/getPositionMargin", map[string]interface{}{
+	return s.GetPositionMarginContext(context.Background(), symbol)
+}
+
+func (s *AccountService) GetPositionMarginContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/getPositionMargin", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *AccountService) SetPositionMargin(symbol, positionSide string, amount float64, marginType int) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/user/setPositionMargin", map[string]interface{}{
+	return s.SetPositionMarginContext(context.Background(), symbol, positionSide, amount, marginType)
+}
+
+func (s *AccountService) SetPositionMarginContext(ctx context.Context, symbol, positionSide string, amount float64, marginType int) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/user/setPositionMargin", map[string]interface{}{
 		"symbol":       symbol,
 		"positionSide": positionSide,
 		"amount":       amount,
@@ -94,70 +132,116 @@ func (s *AccountService) SetPositionMargin(symbol, positionSide string, amount f
 }
 
 func (s *AccountService) GetBalanceHistory(coin string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/balanceHistory", map[string]interface{}{
+	return s.GetBalanceHistoryContext(context.Background(), coin, limit)
+}
+
+func (s *AccountService) GetBalanceHistoryContext(ctx context.Context, coin string, limit int) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/balanceHistory", map[string]interface{}{
 		"coin":  coin,
 		"limit": limit,
 	})
 }
 
 func (s *AccountService) GetAccountPermissions() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/apiPermissions", nil)
+	return s.GetAccountPermissionsContext(context.Background())
+}
+
+func (s *AccountService) GetAccountPermissionsContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/apiPermissions", nil)
 }
 
 func (s *AccountService) GetAPIKey() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/apiKey", nil)
+	return s.GetAPIKeyContext(context.Background())
+}
+
+func (s *AccountService) GetAPIKeyContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/apiKey", nil)
 }
 
 func (s *AccountService) GetUserCommissionRates(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/commissionRate", map[string]interface{}{
+	return s.GetUserCommissionRatesContext(context.Background(), symbol)
+}
+
+func (s *AccountService) GetUserCommissionRatesContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/commissionRate", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *AccountService) GetAPIRateLimits() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/apiRateLimits", nil)
+	return s.GetAPIRateLimitsContext(context.Background())
+}
+
+func (s *AccountService) GetAPIRateLimitsContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/apiRateLimits", nil)
 }
 
 func (s *AccountService) GetDepositHistory(coin string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/depositHistory", map[string]interface{}{
+	return s.GetDepositHistoryContext(context.Background(), coin, limit)
+}
+
+func (s *AccountService) GetDepositHistoryContext(ctx context.Context, coin string, limit int) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/depositHistory", map[string]interface{}{
 		"coin":  coin,
 		"limit": limit,
 	})
 }
 
 func (s *AccountService) GetWithdrawHistory(coin string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/withdrawHistory", map[string]interface{}{
+	return s.GetWithdrawHistoryContext(context.Background(), coin, limit)
+}
+
+func (s *AccountService) GetWithdrawHistoryContext(ctx context.Context, coin string, limit int) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/withdrawHistory", map[string]interface{}{
 		"coin":  coin,
 		"limit": limit,
 	})
 }
 
 func (s *AccountService) GetAssetDetails(asset string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/assetDetails", map[string]interface{}{
+	return s.GetAssetDetailsContext(context.Background(), asset)
+}
+
+func (s *AccountService) GetAssetDetailsContext(ctx context.Context, asset string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/assetDetails", map[string]interface{}{
 		"asset": asset,
 	})
 }
 
 func (s *AccountService) GetAllAssets() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/allAssets", nil)
+	return s.GetAllAssetsContext(context.Background())
+}
+
+func (s *AccountService) GetAllAssetsContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/allAssets", nil)
 }
 
 func (s *AccountService) GetFundingWallet(asset string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/fundingWallet", map[string]interface{}{
+	return s.GetFundingWalletContext(context.Background(), asset)
+}
+
+func (s *AccountService) GetFundingWalletContext(ctx context.Context, asset string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/fundingWallet", map[string]interface{}{
 		"asset": asset,
 	})
 }
 
 func (s *AccountService) DustTransfer(assets []string) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/user/dustTransfer", map[string]interface{}{
+	return s.DustTransferContext(context.Background(), assets)
+}
+
+func (s *AccountService) DustTransferContext(ctx context.Context, assets []string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/user/dustTransfer", map[string]interface{}{
 		"assets": assets,
 	})
 }
 
 func (s *AccountService) GetPositionRisk(symbol *string, recvWindow *int64) (map[string]interface{}, error) {
-	params := map[string]interface{}{
-		"timestamp": time.Now().UnixMilli(),
-	}
+	return s.GetPositionRiskContext(context.Background(), symbol, recvWindow)
+}
+
+func (s *AccountService) GetPositionRiskContext(ctx context.Context, symbol *string, recvWindow *int64) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
 
 	if symbol != nil {
 		params["symbol"] = *symbol
@@ -166,13 +250,15 @@ func (s *AccountService) GetPositionRisk(symbol *string, recvWindow *int64) (map
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/user/positionRisk", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/positionRisk", params)
 }
 
 func (s *AccountService) GetIncomeHistory(symbol *string, incomeType *string, startTime, endTime *int64, limit int, recvWindow *int64) (map[string]interface{}, error) {
-	params := map[string]interface{}{
-		"timestamp": time.Now().UnixMilli(),
-	}
+	return s.GetIncomeHistoryContext(context.Background(), symbol, incomeType, startTime, endTime, limit, recvWindow)
+}
+
+func (s *AccountService) GetIncomeHistoryContext(ctx context.Context, symbol *string, incomeType *string, startTime, endTime *int64, limit int, recvWindow *int64) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
 
 	if symbol != nil {
 		params["symbol"] = *symbol
@@ -193,13 +279,16 @@ func (s *AccountService) GetIncomeHistory(symbol *string, incomeType *string, st
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/user/income", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/income", params)
 }
 
 func (s *AccountService) GetCommissionHistory(symbol string, startTime, endTime *int64, limit int, recvWindow *int64) (map[string]interface{}, error) {
+	return s.GetCommissionHistoryContext(context.Background(), symbol, startTime, endTime, limit, recvWindow)
+}
+
+func (s *AccountService) GetCommissionHistoryContext(ctx context.Context, symbol string, startTime, endTime *int64, limit int, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
-		"symbol":    symbol,
-		"timestamp": time.Now().UnixMilli(),
+		"symbol": symbol,
 	}
 
 	if startTime != nil {
@@ -215,13 +304,15 @@ func (s *AccountService) GetCommissionHistory(symbol string, startTime, endTime
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/user/commissionHistory", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/commissionHistory", params)
 }
 
 func (s *AccountService) GetForceOrders(symbol *string, autoCloseType *string, startTime, endTime *int64, limit int, recvWindow *int64) (map[string]interface{}, error) {
-	params := map[string]interface{}{
-		"timestamp": time.Now().UnixMilli(),
-	}
+	return s.GetForceOrdersContext(context.Background(), symbol, autoCloseType, startTime, endTime, limit, recvWindow)
+}
+
+func (s *AccountService) GetForceOrdersContext(ctx context.Context, symbol *string, autoCloseType *string, startTime, endTime *int64, limit int, recvWindow *int64) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
 
 	if symbol != nil {
 		params["symbol"] = *symbol
@@ -242,30 +333,35 @@ func (s *AccountService) GetForceOrders(symbol *string, autoCloseType *string, s
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/user/forceOrders", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/forceOrders", params)
 }
 
 func (s *AccountService) GetPositionMode(recvWindow *int64) (map[string]interface{}, error) {
-	params := map[string]interface{}{
-		"timestamp": time.Now().UnixMilli(),
-	}
+	return s.GetPositionModeContext(context.Background(), recvWindow)
+}
+
+func (s *AccountService) GetPositionModeContext(ctx context.Context, recvWindow *int64) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/user/positionSide/dual", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/positionSide/dual", params)
 }
 
 func (s *AccountService) SetPositionMode(dualSidePosition bool, recvWindow *int64) (map[string]interface{}, error) {
+	return s.SetPositionModeContext(context.Background(), dualSidePosition, recvWindow)
+}
+
+func (s *AccountService) SetPositionModeContext(ctx context.Context, dualSidePosition bool, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"dualSidePosition": dualSidePosition,
-		"timestamp":        time.Now().UnixMilli(),
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("POST", "/openApi/swap/v2/user/positionSide/dual", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/user/positionSide/dual", params)
 }
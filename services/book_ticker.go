@@ -1,12 +1,12 @@
 package services
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"regexp"
-)
 
-var decimalPattern = regexp.MustCompile(`^-?(?:0|[1-9]\d*)(?:\.\d+)?(?:[eE][+-]?\d+)?$`)
+	"github.com/tigusigalpa/bingx-go/v2/http"
+)
 
 type BookTicker struct {
 	Symbol       string
@@ -28,22 +28,15 @@ type SpotBookTicker struct {
 }
 
 func (s *MarketService) GetBookTickerData(symbol *string) (*BookTicker, error) {
-	params := bookTickerParams(symbol)
-	var response struct {
-		Data json.RawMessage `json:"data"`
-	}
-	if err := s.client.RequestJSON("GET", "/openApi/swap/v2/quote/bookTicker", params, &response); err != nil {
-		return nil, err
-	}
-	if len(response.Data) == 0 || string(response.Data) == "null" {
-		return nil, fmt.Errorf("BingX book ticker response is missing data")
-	}
+	return s.GetBookTickerDataContext(context.Background(), symbol)
+}
 
-	var data struct {
+func (s *MarketService) GetBookTickerDataContext(ctx context.Context, symbol *string) (*BookTicker, error) {
+	data, err := http.DoPublic[struct {
 		BookTicker json.RawMessage `json:"book_ticker"`
-	}
-	if err := json.Unmarshal(response.Data, &data); err != nil {
-		return nil, fmt.Errorf("BingX book ticker response has malformed data: %w", err)
+	}](ctx, s.client, "GET", "/openApi/swap/v2/quote/bookTicker", bookTickerParams(symbol))
+	if err != nil {
+		return nil, err
 	}
 	if len(data.BookTicker) == 0 || string(data.BookTicker) == "null" {
 		return nil, fmt.Errorf("BingX book ticker response is missing data.book_ticker")
@@ -57,20 +50,13 @@ func (s *MarketService) GetBookTickerData(symbol *string) (*BookTicker, error) {
 }
 
 func (s *MarketService) GetSpotBookTickerData(symbol *string) (*SpotBookTicker, error) {
-	params := bookTickerParams(symbol)
-	var response struct {
-		Data json.RawMessage `json:"data"`
-	}
-	if err := s.client.RequestJSON("GET", "/openApi/spot/v1/ticker/bookTicker", params, &response); err != nil {
-		return nil, err
-	}
-	if len(response.Data) == 0 || string(response.Data) == "null" {
-		return nil, fmt.Errorf("BingX spot book ticker response is missing data")
-	}
+	return s.GetSpotBookTickerDataContext(context.Background(), symbol)
+}
 
-	var tickers []SpotBookTicker
-	if err := json.Unmarshal(response.Data, &tickers); err != nil {
-		return nil, fmt.Errorf("BingX spot book ticker response has malformed data: %w", err)
+func (s *MarketService) GetSpotBookTickerDataContext(ctx context.Context, symbol *string) (*SpotBookTicker, error) {
+	tickers, err := http.DoPublic[[]SpotBookTicker](ctx, s.client, "GET", "/openApi/spot/v1/ticker/bookTicker", bookTickerParams(symbol))
+	if err != nil {
+		return nil, err
 	}
 	if len(tickers) == 0 {
 		return nil, fmt.Errorf("BingX spot book ticker response contains no ticker")
@@ -172,7 +158,7 @@ func requiredDecimal(fields map[string]json.RawMessage, name string) (string, er
 
 	var stringValue string
 	if err := json.Unmarshal(raw, &stringValue); err == nil {
-		if !decimalPattern.MatchString(stringValue) {
+		if _, ok := Decimal(stringValue).Rat(); !ok {
 			return "", fmt.Errorf("%s must be a decimal string", name)
 		}
 		return stringValue, nil
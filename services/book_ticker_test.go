@@ -1,6 +1,7 @@
 package services
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -132,6 +133,28 @@ func TestGetBookTickerRawResponseRemainsUnchanged(t *testing.T) {
 	}
 }
 
+func TestGetBookTickerRawResponseWithJSONNumbers(t *testing.T) {
+	fixture, err := os.ReadFile(filepath.Join("testdata", "futures_book_ticker.json"))
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeBookTickerResponse(w, string(fixture))
+	}))
+	defer srv.Close()
+	service := NewMarketService(bxhttp.NewBaseHTTPClient("test-key", "test-secret", srv.URL, "", "hex", bxhttp.WithJSONNumbers()))
+
+	response, err := service.GetBookTicker(nil)
+	if err != nil {
+		t.Fatalf("GetBookTicker() error = %v", err)
+	}
+	bookTicker := response["data"].(map[string]interface{})["book_ticker"].(map[string]interface{})
+	if bidPrice, ok := bookTicker["bid_price"].(json.Number); !ok || bidPrice != "1916.79" {
+		t.Errorf("data.book_ticker.bid_price = %#v, want json.Number(\"1916.79\")", bookTicker["bid_price"])
+	}
+}
+
 func TestGetSpotBookTickerData(t *testing.T) {
 	symbol := "ETH-USDT"
 	service, srv := newBookTickerTestService(t, func(w http.ResponseWriter, r *http.Request) {
@@ -1,6 +1,10 @@
 package coinm
 
-import "github.com/tigusigalpa/bingx-go/v2/http"
+import (
+	"context"
+
+	"github.com/tigusigalpa/bingx-go/v2/http"
+)
 
 type MarketService struct {
 	client *http.BaseHTTPClient
@@ -11,23 +15,39 @@ func NewMarketService(client *http.BaseHTTPClient) *MarketService {
 }
 
 func (s *MarketService) GetContracts() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/cswap/v1/market/contracts", nil)
+	return s.GetContractsContext(context.Background())
+}
+
+func (s *MarketService) GetContractsContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/cswap/v1/market/contracts", nil)
 }
 
 func (s *MarketService) GetTicker(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/cswap/v1/market/ticker", map[string]interface{}{
+	return s.GetTickerContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetTickerContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/cswap/v1/market/ticker", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *MarketService) GetDepth(symbol string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/cswap/v1/market/depth", map[string]interface{}{
+	return s.GetDepthContext(context.Background(), symbol, limit)
+}
+
+func (s *MarketService) GetDepthContext(ctx context.Context, symbol string, limit int) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/cswap/v1/market/depth", map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
 	})
 }
 
 func (s *MarketService) GetKlines(symbol, interval string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetKlinesContext(context.Background(), symbol, interval, limit, startTime, endTime)
+}
+
+func (s *MarketService) GetKlinesContext(ctx context.Context, symbol, interval string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol":   symbol,
 		"interval": interval,
@@ -41,42 +61,66 @@ func (s *MarketService) GetKlines(symbol, interval string, limit int, startTime,
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/cswap/v1/market/klines", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/cswap/v1/market/klines", params)
 }
 
 func (s *MarketService) GetOpenInterest(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/cswap/v1/market/openInterest", map[string]interface{}{
+	return s.GetOpenInterestContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetOpenInterestContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/cswap/v1/market/openInterest", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *MarketService) GetFundingRate(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/cswap/v1/market/premiumIndex", map[string]interface{}{
+	return s.GetFundingRateContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetFundingRateContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/cswap/v1/market/premiumIndex", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *MarketService) GetFundingRateHistory(symbol string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/cswap/v1/market/fundingRate", map[string]interface{}{
+	return s.GetFundingRateHistoryContext(context.Background(), symbol, limit)
+}
+
+func (s *MarketService) GetFundingRateHistoryContext(ctx context.Context, symbol string, limit int) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/cswap/v1/market/fundingRate", map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
 	})
 }
 
 func (s *MarketService) GetMarkPrice(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/cswap/v1/market/premiumIndex", map[string]interface{}{
+	return s.GetMarkPriceContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetMarkPriceContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/cswap/v1/market/premiumIndex", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *MarketService) GetIndexPrice(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/cswap/v1/market/premiumIndex", map[string]interface{}{
+	return s.GetIndexPriceContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetIndexPriceContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/cswap/v1/market/premiumIndex", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *MarketService) GetRecentTrades(symbol string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/cswap/v1/market/trades", map[string]interface{}{
+	return s.GetRecentTradesContext(context.Background(), symbol, limit)
+}
+
+func (s *MarketService) GetRecentTradesContext(ctx context.Context, symbol string, limit int) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/cswap/v1/market/trades", map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
 	})
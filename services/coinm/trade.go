@@ -1,7 +1,7 @@
 package coinm
 
 import (
-	"time"
+	"context"
 
 	"github.com/tigusigalpa/bingx-go/v2/http"
 )
@@ -15,10 +15,18 @@ func NewTradeService(client *http.BaseHTTPClient) *TradeService {
 }
 
 func (s *TradeService) CreateOrder(params map[string]interface{}) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/cswap/v1/trade/order", params)
+	return s.CreateOrderContext(context.Background(), params)
+}
+
+func (s *TradeService) CreateOrderContext(ctx context.Context, params map[string]interface{}) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/cswap/v1/trade/order", params)
 }
 
 func (s *TradeService) CancelOrder(symbol string, orderID *string, clientOrderID *string) (map[string]interface{}, error) {
+	return s.CancelOrderContext(context.Background(), symbol, orderID, clientOrderID)
+}
+
+func (s *TradeService) CancelOrderContext(ctx context.Context, symbol string, orderID *string, clientOrderID *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 	}
@@ -30,75 +38,113 @@ func (s *TradeService) CancelOrder(symbol string, orderID *string, clientOrderID
 		params["clientOrderId"] = *clientOrderID
 	}
 
-	return s.client.Request("DELETE", "/openApi/cswap/v1/trade/cancelOrder", params)
+	return s.client.RequestContext(ctx, "DELETE", "/openApi/cswap/v1/trade/cancelOrder", params)
 }
 
 func (s *TradeService) CancelAllOrders(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("DELETE", "/openApi/cswap/v1/trade/allOpenOrders", map[string]interface{}{
+	return s.CancelAllOrdersContext(context.Background(), symbol)
+}
+
+func (s *TradeService) CancelAllOrdersContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "DELETE", "/openApi/cswap/v1/trade/allOpenOrders", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *TradeService) GetOrder(symbol, orderID string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/cswap/v1/trade/orderDetail", map[string]interface{}{
+	return s.GetOrderContext(context.Background(), symbol, orderID)
+}
+
+func (s *TradeService) GetOrderContext(ctx context.Context, symbol, orderID string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/cswap/v1/trade/orderDetail", map[string]interface{}{
 		"symbol":  symbol,
 		"orderId": orderID,
 	})
 }
 
 func (s *TradeService) GetOpenOrders(symbol *string) (map[string]interface{}, error) {
+	return s.GetOpenOrdersContext(context.Background(), symbol)
+}
+
+func (s *TradeService) GetOpenOrdersContext(ctx context.Context, symbol *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 	if symbol != nil {
 		params["symbol"] = *symbol
 	}
 
-	return s.client.Request("GET", "/openApi/cswap/v1/trade/openOrders", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/cswap/v1/trade/openOrders", params)
 }
 
 func (s *TradeService) GetPositions(symbol *string) (map[string]interface{}, error) {
+	return s.GetPositionsContext(context.Background(), symbol)
+}
+
+func (s *TradeService) GetPositionsContext(ctx context.Context, symbol *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 	if symbol != nil {
 		params["symbol"] = *symbol
 	}
 
-	return s.client.Request("GET", "/openApi/cswap/v1/user/positions", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/cswap/v1/user/positions", params)
 }
 
 func (s *TradeService) GetBalance() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/cswap/v1/user/balance", nil)
+	return s.GetBalanceContext(context.Background())
+}
+
+func (s *TradeService) GetBalanceContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/cswap/v1/user/balance", nil)
 }
 
 func (s *TradeService) GetLeverage(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/cswap/v1/trade/leverage", map[string]interface{}{
+	return s.GetLeverageContext(context.Background(), symbol)
+}
+
+func (s *TradeService) GetLeverageContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/cswap/v1/trade/leverage", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *TradeService) SetLeverage(symbol, side string, leverage int) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/cswap/v1/trade/leverage", map[string]interface{}{
-		"symbol":    symbol,
-		"side":      side,
-		"leverage":  leverage,
-		"timestamp": time.Now().UnixMilli(),
+	return s.SetLeverageContext(context.Background(), symbol, side, leverage)
+}
+
+func (s *TradeService) SetLeverageContext(ctx context.Context, symbol, side string, leverage int) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/cswap/v1/trade/leverage", map[string]interface{}{
+		"symbol":   symbol,
+		"side":     side,
+		"leverage": leverage,
 	})
 }
 
 func (s *TradeService) GetMarginType(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/cswap/v1/trade/marginType", map[string]interface{}{
+	return s.GetMarginTypeContext(context.Background(), symbol)
+}
+
+func (s *TradeService) GetMarginTypeContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/cswap/v1/trade/marginType", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *TradeService) SetMarginType(symbol, marginType string) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/cswap/v1/trade/marginType", map[string]interface{}{
+	return s.SetMarginTypeContext(context.Background(), symbol, marginType)
+}
+
+func (s *TradeService) SetMarginTypeContext(ctx context.Context, symbol, marginType string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/cswap/v1/trade/marginType", map[string]interface{}{
 		"symbol":     symbol,
 		"marginType": marginType,
-		"timestamp":  time.Now().UnixMilli(),
 	})
 }
 
 func (s *TradeService) SetPositionMargin(symbol, positionSide string, amount float64, marginType int) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/cswap/v1/trade/positionMargin", map[string]interface{}{
+	return s.SetPositionMarginContext(context.Background(), symbol, positionSide, amount, marginType)
+}
+
+func (s *TradeService) SetPositionMarginContext(ctx context.Context, symbol, positionSide string, amount float64, marginType int) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/cswap/v1/trade/positionMargin", map[string]interface{}{
 		"symbol":       symbol,
 		"positionSide": positionSide,
 		"amount":       amount,
@@ -107,6 +153,10 @@ func (s *TradeService) SetPositionMargin(symbol, positionSide string, amount flo
 }
 
 func (s *TradeService) GetOrderHistory(symbol string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetOrderHistoryContext(context.Background(), symbol, limit, startTime, endTime)
+}
+
+func (s *TradeService) GetOrderHistoryContext(ctx context.Context, symbol string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
@@ -119,10 +169,14 @@ func (s *TradeService) GetOrderHistory(symbol string, limit int, startTime, endT
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/cswap/v1/trade/orderHistory", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/cswap/v1/trade/orderHistory", params)
 }
 
 func (s *TradeService) GetUserTrades(symbol string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetUserTradesContext(context.Background(), symbol, limit, startTime, endTime)
+}
+
+func (s *TradeService) GetUserTradesContext(ctx context.Context, symbol string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
@@ -135,13 +189,15 @@ func (s *TradeService) GetUserTrades(symbol string, limit int, startTime, endTim
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/cswap/v1/trade/allFillOrders", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/cswap/v1/trade/allFillOrders", params)
 }
 
 func (s *TradeService) GetPositionRisk(symbol *string, recvWindow *int64) (map[string]interface{}, error) {
-	params := map[string]interface{}{
-		"timestamp": time.Now().UnixMilli(),
-	}
+	return s.GetPositionRiskContext(context.Background(), symbol, recvWindow)
+}
+
+func (s *TradeService) GetPositionRiskContext(ctx context.Context, symbol *string, recvWindow *int64) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
 
 	if symbol != nil {
 		params["symbol"] = *symbol
@@ -150,13 +206,15 @@ func (s *TradeService) GetPositionRisk(symbol *string, recvWindow *int64) (map[s
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/cswap/v1/user/positions", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/cswap/v1/user/positions", params)
 }
 
 func (s *TradeService) GetIncomeHistory(symbol *string, incomeType *string, startTime, endTime *int64, limit int, recvWindow *int64) (map[string]interface{}, error) {
-	params := map[string]interface{}{
-		"timestamp": time.Now().UnixMilli(),
-	}
+	return s.GetIncomeHistoryContext(context.Background(), symbol, incomeType, startTime, endTime, limit, recvWindow)
+}
+
+func (s *TradeService) GetIncomeHistoryContext(ctx context.Context, symbol *string, incomeType *string, startTime, endTime *int64, limit int, recvWindow *int64) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
 
 	if symbol != nil {
 		params["symbol"] = *symbol
@@ -177,5 +235,5 @@ func (s *TradeService) GetIncomeHistory(symbol *string, incomeType *string, star
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/cswap/v1/user/income", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/cswap/v1/user/income", params)
 }
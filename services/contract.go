@@ -1,7 +1,7 @@
 package services
 
 import (
-	"time"
+	"context"
 
 	"github.com/tigusigalpa/bingx-go/v2/http"
 )
@@ -15,22 +15,28 @@ func NewContractService(client *http.BaseHTTPClient) *ContractService {
 }
 
 func (s *ContractService) GetAllPositions(timestamp, recvWindow *int64) (map[string]interface{}, error) {
+	return s.GetAllPositionsContext(context.Background(), timestamp, recvWindow)
+}
+
+func (s *ContractService) GetAllPositionsContext(ctx context.Context, timestamp, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 
 	if timestamp != nil {
 		params["timestamp"] = *timestamp
-	} else {
-		params["timestamp"] = time.Now().UnixMilli()
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/contract/v1/allPosition", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/contract/v1/allPosition", params)
 }
 
 func (s *ContractService) GetAllOrders(symbol string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetAllOrdersContext(context.Background(), symbol, limit, startTime, endTime)
+}
+
+func (s *ContractService) GetAllOrdersContext(ctx context.Context, symbol string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
@@ -43,21 +49,23 @@ func (s *ContractService) GetAllOrders(symbol string, limit int, startTime, endT
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/contract/v1/allOrders", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/contract/v1/allOrders", params)
 }
 
 func (s *ContractService) GetBalance(timestamp, recvWindow *int64) (map[string]interface{}, error) {
+	return s.GetBalanceContext(context.Background(), timestamp, recvWindow)
+}
+
+func (s *ContractService) GetBalanceContext(ctx context.Context, timestamp, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 
 	if timestamp != nil {
 		params["timestamp"] = *timestamp
-	} else {
-		params["timestamp"] = time.Now().UnixMilli()
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/contract/v1/balance", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/contract/v1/balance", params)
 }
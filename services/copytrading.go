@@ -1,6 +1,10 @@
 package services
 
-import "github.com/tigusigalpa/bingx-go/v2/http"
+import (
+	"context"
+
+	"github.com/tigusigalpa/bingx-go/v2/http"
+)
 
 type CopyTradingService struct {
 	client *http.BaseHTTPClient
@@ -11,18 +15,30 @@ func NewCopyTradingService(client *http.BaseHTTPClient) *CopyTradingService {
 }
 
 func (s *CopyTradingService) GetCurrentTrackOrders(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/copy/v1/trader/currentTrack", map[string]interface{}{
+	return s.GetCurrentTrackOrdersContext(context.Background(), symbol)
+}
+
+func (s *CopyTradingService) GetCurrentTrackOrdersContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/copy/v1/trader/currentTrack", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *CopyTradingService) CloseTrackOrder(orderNumber string) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/copy/v1/trader/closeTrack", map[string]interface{}{
+	return s.CloseTrackOrderContext(context.Background(), orderNumber)
+}
+
+func (s *CopyTradingService) CloseTrackOrderContext(ctx context.Context, orderNumber string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/copy/v1/trader/closeTrack", map[string]interface{}{
 		"orderNumber": orderNumber,
 	})
 }
 
 func (s *CopyTradingService) SetTPSL(positionID string, stopLoss, takeProfit *float64) (map[string]interface{}, error) {
+	return s.SetTPSLContext(context.Background(), positionID, stopLoss, takeProfit)
+}
+
+func (s *CopyTradingService) SetTPSLContext(ctx context.Context, positionID string, stopLoss, takeProfit *float64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"positionId": positionID,
 	}
@@ -34,57 +50,97 @@ func (s *CopyTradingService) SetTPSL(positionID string, stopLoss, takeProfit *fl
 		params["takeProfit"] = *takeProfit
 	}
 
-	return s.client.Request("POST", "/openApi/copy/v1/trader/setTPSL", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/copy/v1/trader/setTPSL", params)
 }
 
 func (s *CopyTradingService) GetTraderDetail() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/copy/v1/trader/detail", nil)
+	return s.GetTraderDetailContext(context.Background())
+}
+
+func (s *CopyTradingService) GetTraderDetailContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/copy/v1/trader/detail", nil)
 }
 
 func (s *CopyTradingService) GetProfitSummary() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/copy/v1/trader/profitSummary", nil)
+	return s.GetProfitSummaryContext(context.Background())
+}
+
+func (s *CopyTradingService) GetProfitSummaryContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/copy/v1/trader/profitSummary", nil)
 }
 
 func (s *CopyTradingService) GetProfitDetail(pageIndex, pageSize int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/copy/v1/trader/profitDetail", map[string]interface{}{
+	return s.GetProfitDetailContext(context.Background(), pageIndex, pageSize)
+}
+
+func (s *CopyTradingService) GetProfitDetailContext(ctx context.Context, pageIndex, pageSize int) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/copy/v1/trader/profitDetail", map[string]interface{}{
 		"pageIndex": pageIndex,
 		"pageSize":  pageSize,
 	})
 }
 
 func (s *CopyTradingService) SetCommission(commission float64) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/copy/v1/trader/setCommission", map[string]interface{}{
+	return s.SetCommissionContext(context.Background(), commission)
+}
+
+func (s *CopyTradingService) SetCommissionContext(ctx context.Context, commission float64) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/copy/v1/trader/setCommission", map[string]interface{}{
 		"commission": commission,
 	})
 }
 
 func (s *CopyTradingService) GetTradingPairs() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/copy/v1/trader/tradingPairs", nil)
+	return s.GetTradingPairsContext(context.Background())
+}
+
+func (s *CopyTradingService) GetTradingPairsContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/copy/v1/trader/tradingPairs", nil)
 }
 
 func (s *CopyTradingService) SellSpotOrder(buyOrderID string) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/copy/v1/spot/trader/sell", map[string]interface{}{
+	return s.SellSpotOrderContext(context.Background(), buyOrderID)
+}
+
+func (s *CopyTradingService) SellSpotOrderContext(ctx context.Context, buyOrderID string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/copy/v1/spot/trader/sell", map[string]interface{}{
 		"buyOrderId": buyOrderID,
 	})
 }
 
 func (s *CopyTradingService) GetSpotTraderDetail() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/copy/v1/spot/trader/detail", nil)
+	return s.GetSpotTraderDetailContext(context.Background())
+}
+
+func (s *CopyTradingService) GetSpotTraderDetailContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/copy/v1/spot/trader/detail", nil)
 }
 
 func (s *CopyTradingService) GetSpotProfitSummary() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/copy/v1/spot/trader/profitSummary", nil)
+	return s.GetSpotProfitSummaryContext(context.Background())
+}
+
+func (s *CopyTradingService) GetSpotProfitSummaryContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/copy/v1/spot/trader/profitSummary", nil)
 }
 
 func (s *CopyTradingService) GetSpotProfitDetail(pageIndex, pageSize int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/copy/v1/spot/trader/profitDetail", map[string]interface{}{
+	return s.GetSpotProfitDetailContext(context.Background(), pageIndex, pageSize)
+}
+
+func (s *CopyTradingService) GetSpotProfitDetailContext(ctx context.Context, pageIndex, pageSize int) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/copy/v1/spot/trader/profitDetail", map[string]interface{}{
 		"pageIndex": pageIndex,
 		"pageSize":  pageSize,
 	})
 }
 
 func (s *CopyTradingService) GetSpotHistoryOrders(pageIndex, pageSize int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/copy/v1/spot/trader/historyOrders", map[string]interface{}{
+	return s.GetSpotHistoryOrdersContext(context.Background(), pageIndex, pageSize)
+}
+
+func (s *CopyTradingService) GetSpotHistoryOrdersContext(ctx context.Context, pageIndex, pageSize int) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/copy/v1/spot/trader/historyOrders", map[string]interface{}{
 		"pageIndex": pageIndex,
 		"pageSize":  pageSize,
 	})
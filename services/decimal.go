@@ -0,0 +1,17 @@
+package services
+
+import "github.com/tigusigalpa/bingx-go/v2/decimal"
+
+// Decimal is an exact decimal number, see decimal.Decimal. It is an alias,
+// so values convert freely between the two names.
+type Decimal = decimal.Decimal
+
+// ParseDecimal validates s as a decimal number, see decimal.Parse.
+func ParseDecimal(s string) (Decimal, error) {
+	return decimal.Parse(s)
+}
+
+// DecimalFromFloat returns the shortest decimal that parses back to f.
+func DecimalFromFloat(f float64) Decimal {
+	return decimal.FromFloat(f)
+}
@@ -1,6 +1,10 @@
 package services
 
-import "github.com/tigusigalpa/bingx-go/v2/http"
+import (
+	"context"
+
+	"github.com/tigusigalpa/bingx-go/v2/http"
+)
 
 type ListenKeyService struct {
 	client *http.BaseHTTPClient
@@ -11,17 +15,29 @@ func NewListenKeyService(client *http.BaseHTTPClient) *ListenKeyService {
 }
 
 func (s *ListenKeyService) Generate() (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/user/auth/userDataStream", nil)
+	return s.GenerateContext(context.Background())
+}
+
+func (s *ListenKeyService) GenerateContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/user/auth/userDataStream", nil)
 }
 
 func (s *ListenKeyService) Extend(listenKey string) (map[string]interface{}, error) {
-	return s.client.Request("PUT", "/openApi/user/auth/userDataStream", map[string]interface{}{
+	return s.ExtendContext(context.Background(), listenKey)
+}
+
+func (s *ListenKeyService) ExtendContext(ctx context.Context, listenKey string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "PUT", "/openApi/user/auth/userDataStream", map[string]interface{}{
 		"listenKey": listenKey,
 	})
 }
 
 func (s *ListenKeyService) Delete(listenKey string) (map[string]interface{}, error) {
-	return s.client.Request("DELETE", "/openApi/user/auth/userDataStream", map[string]interface{}{
+	return s.DeleteContext(context.Background(), listenKey)
+}
+
+func (s *ListenKeyService) DeleteContext(ctx context.Context, listenKey string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "DELETE", "/openApi/user/auth/userDataStream", map[string]interface{}{
 		"listenKey": listenKey,
 	})
 }
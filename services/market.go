@@ -1,6 +1,12 @@
 package services
 
-import "github.com/tigusigalpa/bingx-go/v2/http"
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tigusigalpa/bingx-go/v2/http"
+)
 
 type MarketService struct {
 	client *http.BaseHTTPClient
@@ -11,23 +17,35 @@ func NewMarketService(client *http.BaseHTTPClient) *MarketService {
 }
 
 func (s *MarketService) GetFuturesSymbols() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/contracts", nil)
+	return s.GetFuturesSymbolsContext(context.Background())
+}
+
+func (s *MarketService) GetFuturesSymbolsContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/contracts", nil)
 }
 
 // GetSpotSymbols retrieves spot trading symbols
 // Response includes maxMarketNotional (max notional for market orders)
 // and status field (0=Offline, 1=Online, 5=Pre-open, 10=Accessed, 25=Suspended, 29=Pre-Delisted, 30=Delisted)
 func (s *MarketService) GetSpotSymbols() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/spot/v1/common/symbols", nil)
+	return s.GetSpotSymbolsContext(context.Background())
+}
+
+func (s *MarketService) GetSpotSymbolsContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/spot/v1/common/symbols", nil)
 }
 
 func (s *MarketService) GetAllSymbols() (map[string]interface{}, error) {
-	spot, err := s.GetSpotSymbols()
+	return s.GetAllSymbolsContext(context.Background())
+}
+
+func (s *MarketService) GetAllSymbolsContext(ctx context.Context) (map[string]interface{}, error) {
+	spot, err := s.GetSpotSymbolsContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	futures, err := s.GetFuturesSymbols()
+	futures, err := s.GetFuturesSymbolsContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -39,36 +57,60 @@ func (s *MarketService) GetAllSymbols() (map[string]interface{}, error) {
 }
 
 func (s *MarketService) GetSymbols() (map[string]interface{}, error) {
-	return s.GetFuturesSymbols()
+	return s.GetSymbolsContext(context.Background())
+}
+
+func (s *MarketService) GetSymbolsContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.GetFuturesSymbolsContext(ctx)
 }
 
 func (s *MarketService) GetLatestPrice(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/market/latestPrice", map[string]interface{}{
+	return s.GetLatestPriceContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetLatestPriceContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/latestPrice", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *MarketService) GetSpotLatestPrice(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/spot/v1/market/ticker/price", map[string]interface{}{
+	return s.GetSpotLatestPriceContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetSpotLatestPriceContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/spot/v1/market/ticker/price", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *MarketService) GetDepth(symbol string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/depth", map[string]interface{}{
+	return s.GetDepthContext(context.Background(), symbol, limit)
+}
+
+func (s *MarketService) GetDepthContext(ctx context.Context, symbol string, limit int) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/depth", map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
 	})
 }
 
 func (s *MarketService) GetSpotDepth(symbol string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/spot/v1/market/depth", map[string]interface{}{
+	return s.GetSpotDepthContext(context.Background(), symbol, limit)
+}
+
+func (s *MarketService) GetSpotDepthContext(ctx context.Context, symbol string, limit int) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/spot/v1/market/depth", map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
 	})
 }
 
 func (s *MarketService) GetKlines(symbol, interval string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetKlinesContext(context.Background(), symbol, interval, limit, startTime, endTime)
+}
+
+func (s *MarketService) GetKlinesContext(ctx context.Context, symbol, interval string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol":   symbol,
 		"interval": interval,
@@ -82,12 +124,16 @@ func (s *MarketService) GetKlines(symbol, interval string, limit int, startTime,
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v3/quote/klines", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v3/quote/klines", params)
 }
 
 // GetSpotKlines retrieves spot K-line (candlestick) data
 // timeZone: optional timezone offset (0=UTC (default), 8=UTC+8)
 func (s *MarketService) GetSpotKlines(symbol, interval string, limit int, startTime, endTime, timeZone *int64) (map[string]interface{}, error) {
+	return s.GetSpotKlinesContext(context.Background(), symbol, interval, limit, startTime, endTime, timeZone)
+}
+
+func (s *MarketService) GetSpotKlinesContext(ctx context.Context, symbol, interval string, limit int, startTime, endTime, timeZone *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol":   symbol,
 		"interval": interval,
@@ -104,41 +150,61 @@ func (s *MarketService) GetSpotKlines(symbol, interval string, limit int, startT
 		params["timeZone"] = *timeZone
 	}
 
-	return s.client.Request("GET", "/openApi/spot/v2/market/kline", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/spot/v2/market/kline", params)
 }
 
 func (s *MarketService) Get24hrTicker(symbol *string) (map[string]interface{}, error) {
+	return s.Get24hrTickerContext(context.Background(), symbol)
+}
+
+func (s *MarketService) Get24hrTickerContext(ctx context.Context, symbol *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 	if symbol != nil {
 		params["symbol"] = *symbol
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/quote/ticker", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/ticker", params)
 }
 
 func (s *MarketService) GetSpot24hrTicker(symbol *string) (map[string]interface{}, error) {
+	return s.GetSpot24hrTickerContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetSpot24hrTickerContext(ctx context.Context, symbol *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 	if symbol != nil {
 		params["symbol"] = *symbol
 	}
 
-	return s.client.Request("GET", "/openApi/spot/v1/market/ticker/24hr", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/spot/v1/market/ticker/24hr", params)
 }
 
 func (s *MarketService) GetFundingRateHistory(symbol string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/market/fundingRate/history", map[string]interface{}{
+	return s.GetFundingRateHistoryContext(context.Background(), symbol, limit)
+}
+
+func (s *MarketService) GetFundingRateHistoryContext(ctx context.Context, symbol string, limit int) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/fundingRate/history", map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
 	})
 }
 
 func (s *MarketService) GetMarkPrice(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/premiumIndex", map[string]interface{}{
+	return s.GetMarkPriceContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetMarkPriceContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/premiumIndex", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *MarketService) GetPremiumIndexKlines(symbol, interval string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetPremiumIndexKlinesContext(context.Background(), symbol, interval, limit, startTime, endTime)
+}
+
+func (s *MarketService) GetPremiumIndexKlinesContext(ctx context.Context, symbol, interval string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol":   symbol,
 		"interval": interval,
@@ -152,10 +218,14 @@ func (s *MarketService) GetPremiumIndexKlines(symbol, interval string, limit int
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/market/premiumIndexKline", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/premiumIndexKline", params)
 }
 
 func (s *MarketService) GetAggregateTrades(symbol string, limit int, fromID, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetAggregateTradesContext(context.Background(), symbol, limit, fromID, startTime, endTime)
+}
+
+func (s *MarketService) GetAggregateTradesContext(ctx context.Context, symbol string, limit int, fromID, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
@@ -171,17 +241,25 @@ func (s *MarketService) GetAggregateTrades(symbol string, limit int, fromID, sta
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/market/aggTrades", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/aggTrades", params)
 }
 
 func (s *MarketService) GetRecentTrades(symbol string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/trades", map[string]interface{}{
+	return s.GetRecentTradesContext(context.Background(), symbol, limit)
+}
+
+func (s *MarketService) GetRecentTradesContext(ctx context.Context, symbol string, limit int) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/trades", map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
 	})
 }
 
 func (s *MarketService) GetSpotAggregateTrades(symbol string, limit int, fromID *int64) (map[string]interface{}, error) {
+	return s.GetSpotAggregateTradesContext(context.Background(), symbol, limit, fromID)
+}
+
+func (s *MarketService) GetSpotAggregateTradesContext(ctx context.Context, symbol string, limit int, fromID *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
@@ -191,25 +269,66 @@ func (s *MarketService) GetSpotAggregateTrades(symbol string, limit int, fromID
 		params["fromId"] = *fromID
 	}
 
-	return s.client.Request("GET", "/openApi/spot/v1/market/aggTrades", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/spot/v1/market/aggTrades", params)
 }
 
 func (s *MarketService) GetSpotRecentTrades(symbol string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/spot/v1/market/trades", map[string]interface{}{
+	return s.GetSpotRecentTradesContext(context.Background(), symbol, limit)
+}
+
+func (s *MarketService) GetSpotRecentTradesContext(ctx context.Context, symbol string, limit int) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/spot/v1/market/trades", map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
 	})
 }
 
 func (s *MarketService) GetServerTime() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/market/time", nil)
+	return s.GetServerTimeContext(context.Background())
+}
+
+func (s *MarketService) GetServerTimeContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/time", nil)
 }
 
 func (s *MarketService) GetSpotServerTime() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/spot/v1/market/time", nil)
+	return s.GetSpotServerTimeContext(context.Background())
+}
+
+func (s *MarketService) GetSpotServerTimeContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/spot/v1/market/time", nil)
+}
+
+// FetchServerTime returns the futures server time. Its signature matches
+// http.ServerTimeSource.
+func (s *MarketService) FetchServerTime(ctx context.Context) (time.Time, error) {
+	return s.fetchServerTime(ctx, "/openApi/swap/v2/market/time")
+}
+
+// FetchSpotServerTime returns the spot server time. Its signature matches
+// http.ServerTimeSource.
+func (s *MarketService) FetchSpotServerTime(ctx context.Context) (time.Time, error) {
+	return s.fetchServerTime(ctx, "/openApi/spot/v1/market/time")
+}
+
+func (s *MarketService) fetchServerTime(ctx context.Context, path string) (time.Time, error) {
+	data, err := http.DoPublic[struct {
+		ServerTime *int64 `json:"serverTime"`
+	}](ctx, s.client, "GET", path, nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if data.ServerTime == nil {
+		return time.Time{}, fmt.Errorf("BingX server time response is missing data.serverTime")
+	}
+	return time.UnixMilli(*data.ServerTime), nil
 }
 
 func (s *MarketService) GetContinuousKlines(symbol, interval string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetContinuousKlinesContext(context.Background(), symbol, interval, limit, startTime, endTime)
+}
+
+func (s *MarketService) GetContinuousKlinesContext(ctx context.Context, symbol, interval string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol":   symbol,
 		"interval": interval,
@@ -223,10 +342,14 @@ func (s *MarketService) GetContinuousKlines(symbol, interval string, limit int,
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/market/continuousKline", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/continuousKline", params)
 }
 
 func (s *MarketService) GetIndexPriceKlines(symbol, interval string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetIndexPriceKlinesContext(context.Background(), symbol, interval, limit, startTime, endTime)
+}
+
+func (s *MarketService) GetIndexPriceKlinesContext(ctx context.Context, symbol, interval string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol":   symbol,
 		"interval": interval,
@@ -240,24 +363,36 @@ func (s *MarketService) GetIndexPriceKlines(symbol, interval string, limit int,
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/market/indexPriceKline", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/indexPriceKline", params)
 }
 
 func (s *MarketService) GetTopLongShortRatio(symbol string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/market/topLongShortRatio", map[string]interface{}{
+	return s.GetTopLongShortRatioContext(context.Background(), symbol, limit)
+}
+
+func (s *MarketService) GetTopLongShortRatioContext(ctx context.Context, symbol string, limit int) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/topLongShortRatio", map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
 	})
 }
 
 func (s *MarketService) GetTopTradersPositionRatio(symbol string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/market/topTraderPositionRatio", map[string]interface{}{
+	return s.GetTopTradersPositionRatioContext(context.Background(), symbol, limit)
+}
+
+func (s *MarketService) GetTopTradersPositionRatioContext(ctx context.Context, symbol string, limit int) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/topTraderPositionRatio", map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
 	})
 }
 
 func (s *MarketService) GetHistoricalTopLongShortRatio(symbol string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetHistoricalTopLongShortRatioContext(context.Background(), symbol, limit, startTime, endTime)
+}
+
+func (s *MarketService) GetHistoricalTopLongShortRatioContext(ctx context.Context, symbol string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
@@ -270,10 +405,14 @@ func (s *MarketService) GetHistoricalTopLongShortRatio(symbol string, limit int,
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/market/topLongShortAccount", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/topLongShortAccount", params)
 }
 
 func (s *MarketService) GetTopTradersLongShortRatio(symbol string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetTopTradersLongShortRatioContext(context.Background(), symbol, limit, startTime, endTime)
+}
+
+func (s *MarketService) GetTopTradersLongShortRatioContext(ctx context.Context, symbol string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
@@ -286,10 +425,14 @@ func (s *MarketService) GetTopTradersLongShortRatio(symbol string, limit int, st
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/market/topLongShortPosition", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/topLongShortPosition", params)
 }
 
 func (s *MarketService) GetBasis(symbol, contractType string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetBasisContext(context.Background(), symbol, contractType, limit, startTime, endTime)
+}
+
+func (s *MarketService) GetBasisContext(ctx context.Context, symbol, contractType string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol":       symbol,
 		"contractType": contractType,
@@ -303,16 +446,24 @@ func (s *MarketService) GetBasis(symbol, contractType string, limit int, startTi
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/market/basis", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/basis", params)
 }
 
 func (s *MarketService) GetOpenInterest(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/openInterest", map[string]interface{}{
+	return s.GetOpenInterestContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetOpenInterestContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/openInterest", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *MarketService) GetOpenInterestHistory(symbol, period string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetOpenInterestHistoryContext(context.Background(), symbol, period, limit, startTime, endTime)
+}
+
+func (s *MarketService) GetOpenInterestHistoryContext(ctx context.Context, symbol, period string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 		"period": period,
@@ -326,44 +477,64 @@ func (s *MarketService) GetOpenInterestHistory(symbol, period string, limit int,
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/market/openInterest/history", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/openInterest/history", params)
 }
 
 func (s *MarketService) GetFundingRateInfo(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/fundingRate", map[string]interface{}{
+	return s.GetFundingRateInfoContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetFundingRateInfoContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/fundingRate", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *MarketService) GetBookTicker(symbol *string) (map[string]interface{}, error) {
+	return s.GetBookTickerContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetBookTickerContext(ctx context.Context, symbol *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 	if symbol != nil {
 		params["symbol"] = *symbol
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/quote/bookTicker", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/bookTicker", params)
 }
 
 func (s *MarketService) GetSpotBookTicker(symbol *string) (map[string]interface{}, error) {
+	return s.GetSpotBookTickerContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetSpotBookTickerContext(ctx context.Context, symbol *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 	if symbol != nil {
 		params["symbol"] = *symbol
 	}
 
-	return s.client.Request("GET", "/openApi/spot/v1/market/bookTicker", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/spot/v1/market/bookTicker", params)
 }
 
 func (s *MarketService) GetIndexPrice(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/market/indexPrice", map[string]interface{}{
+	return s.GetIndexPriceContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetIndexPriceContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/indexPrice", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 func (s *MarketService) GetTickerPrice(symbol *string) (map[string]interface{}, error) {
+	return s.GetTickerPriceContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetTickerPriceContext(ctx context.Context, symbol *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 	if symbol != nil {
 		params["symbol"] = *symbol
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/market/ticker/price", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/ticker/price", params)
 }
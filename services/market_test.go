@@ -1,7 +1,11 @@
 package services
 
 import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/tigusigalpa/bingx-go/v2/http"
 )
@@ -323,3 +327,67 @@ func TestGetAllSymbols(t *testing.T) {
 		t.Skip("Skipping test - would require mock HTTP server")
 	}
 }
+
+func TestFetchServerTime(t *testing.T) {
+	tests := []struct {
+		name  string
+		path  string
+		fetch func(*MarketService, context.Context) (time.Time, error)
+	}{
+		{"futures", "/openApi/swap/v2/market/time", (*MarketService).FetchServerTime},
+		{"spot", "/openApi/spot/v1/market/time", (*MarketService).FetchSpotServerTime},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, srv := newBookTickerTestService(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if r.URL.Path != tt.path {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				writeBookTickerResponse(w, `{"code":0,"msg":"","data":{"serverTime":1702731500123}}`)
+			})
+			defer srv.Close()
+
+			got, err := tt.fetch(service, context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.UnixMilli() != 1702731500123 {
+				t.Errorf("unexpected server time %d", got.UnixMilli())
+			}
+		})
+	}
+}
+
+func TestFetchServerTime_MissingData(t *testing.T) {
+	service, srv := newBookTickerTestService(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		writeBookTickerResponse(w, `{"code":0,"msg":""}`)
+	})
+	defer srv.Close()
+
+	if _, err := service.FetchServerTime(context.Background()); err == nil {
+		t.Fatal("expected an error for a response without data")
+	}
+}
+
+func TestMarketEndpoints_AreUnsigned(t *testing.T) {
+	var query string
+	var apiKey string
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		query = r.URL.RawQuery
+		apiKey = r.Header.Get("X-BX-APIKEY")
+		writeBookTickerResponse(w, `{"code":0,"msg":"","data":{}}`)
+	}))
+	defer srv.Close()
+
+	service := NewMarketService(http.NewBaseHTTPClient("", "", srv.URL, "", "hex"))
+	if _, err := service.GetDepth("BTC-USDT", 5); err != nil {
+		t.Fatalf("public endpoints should work without credentials: %v", err)
+	}
+	if query != "limit=5&symbol=BTC-USDT" {
+		t.Errorf("expected an unsigned query, got %q", query)
+	}
+	if apiKey != "" {
+		t.Errorf("the API key header must not be sent, got %q", apiKey)
+	}
+}
@@ -0,0 +1,577 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Order sides, position sides, time-in-force values and trigger price types
+// of perpetual futures orders.
+const (
+	SideBuy  = "BUY"
+	SideSell = "SELL"
+
+	PositionSideBoth  = "BOTH"
+	PositionSideLong  = "LONG"
+	PositionSideShort = "SHORT"
+
+	TimeInForceGTC      = "GTC"
+	TimeInForceIOC      = "IOC"
+	TimeInForceFOK      = "FOK"
+	TimeInForcePostOnly = "PostOnly"
+
+	WorkingTypeMarkPrice     = "MARK_PRICE"
+	WorkingTypeContractPrice = "CONTRACT_PRICE"
+	WorkingTypeIndexPrice    = "INDEX_PRICE"
+)
+
+// TWAP price variance types.
+const (
+	TWAPPriceTypeConstant   = "constant"
+	TWAPPriceTypePercentage = "percentage"
+)
+
+// FuturesOrder builds the parameters of a perpetual futures order for
+// TradeService.CreateOrder or CreateTestOrder. Field names are fixed by the
+// setters, and Params checks required fields and their combinations before
+// anything is sent:
+//
+//	params, err := services.NewFuturesOrder("BTC-USDT").Sell().Limit("64250.5").Qty("0.01").ReduceOnly().Params()
+//	if err != nil {
+//		return err
+//	}
+//	order, err := client.Trade().CreateOrder(params)
+//
+// Prices and quantities are Decimals, so untyped string constants are sent
+// exactly as written.
+type FuturesOrder struct {
+	symbol        string
+	side          string
+	positionSide  string
+	orderType     string
+	quantity      Decimal
+	price         Decimal
+	stopPrice     Decimal
+	priceRate     Decimal
+	timeInForce   string
+	workingType   string
+	clientOrderID string
+	reduceOnly    bool
+	closePosition bool
+}
+
+// NewFuturesOrder starts an order for symbol.
+func NewFuturesOrder(symbol string) *FuturesOrder {
+	return &FuturesOrder{symbol: symbol}
+}
+
+func (o *FuturesOrder) Buy() *FuturesOrder {
+	o.side = SideBuy
+	return o
+}
+
+func (o *FuturesOrder) Sell() *FuturesOrder {
+	o.side = SideSell
+	return o
+}
+
+// Long and Short select the position side in hedge mode. Without them the
+// order is sent without positionSide, which BingX treats as one-way mode.
+func (o *FuturesOrder) Long() *FuturesOrder {
+	o.positionSide = PositionSideLong
+	return o
+}
+
+func (o *FuturesOrder) Short() *FuturesOrder {
+	o.positionSide = PositionSideShort
+	return o
+}
+
+func (o *FuturesOrder) Market() *FuturesOrder {
+	o.orderType = OrderTypeMarket
+	return o
+}
+
+func (o *FuturesOrder) Limit(price Decimal) *FuturesOrder {
+	o.orderType = OrderTypeLimit
+	o.price = price
+	return o
+}
+
+// Stop places a limit order at price once the trigger stopPrice is reached.
+func (o *FuturesOrder) Stop(price, stopPrice Decimal) *FuturesOrder {
+	o.orderType = OrderTypeStop
+	o.price = price
+	o.stopPrice = stopPrice
+	return o
+}
+
+func (o *FuturesOrder) StopMarket(stopPrice Decimal) *FuturesOrder {
+	o.orderType = OrderTypeStopMarket
+	o.stopPrice = stopPrice
+	return o
+}
+
+func (o *FuturesOrder) TakeProfit(price, stopPrice Decimal) *FuturesOrder {
+	o.orderType = OrderTypeTakeProfit
+	o.price = price
+	o.stopPrice = stopPrice
+	return o
+}
+
+func (o *FuturesOrder) TakeProfitMarket(stopPrice Decimal) *FuturesOrder {
+	o.orderType = OrderTypeTakeProfitMarket
+	o.stopPrice = stopPrice
+	return o
+}
+
+func (o *FuturesOrder) TriggerLimit(price, stopPrice Decimal) *FuturesOrder {
+	o.orderType = OrderTypeTriggerLimit
+	o.price = price
+	o.stopPrice = stopPrice
+	return o
+}
+
+// TrailingStopMarket trails the best price by priceRate, a fraction in
+// (0, 1], e.g. "0.01" for 1%.
+func (o *FuturesOrder) TrailingStopMarket(priceRate Decimal) *FuturesOrder {
+	o.orderType = OrderTypeTrailingStopMarket
+	o.priceRate = priceRate
+	return o
+}
+
+func (o *FuturesOrder) Qty(quantity Decimal) *FuturesOrder {
+	o.quantity = quantity
+	return o
+}
+
+// TimeInForce sets one of the TimeInForce constants.
+func (o *FuturesOrder) TimeInForce(timeInForce string) *FuturesOrder {
+	o.timeInForce = timeInForce
+	return o
+}
+
+// WorkingType sets the price that fires a trigger order, one of the
+// WorkingType constants.
+func (o *FuturesOrder) WorkingType(workingType string) *FuturesOrder {
+	o.workingType = workingType
+	return o
+}
+
+func (o *FuturesOrder) ClientOrderID(id string) *FuturesOrder {
+	o.clientOrderID = id
+	return o
+}
+
+// ReduceOnly only lets the order shrink the position. BingX accepts it in
+// one-way mode only.
+func (o *FuturesOrder) ReduceOnly() *FuturesOrder {
+	o.reduceOnly = true
+	return o
+}
+
+// ClosePosition makes a STOP_MARKET or TAKE_PROFIT_MARKET order close the
+// whole position, so no quantity is needed.
+func (o *FuturesOrder) ClosePosition() *FuturesOrder {
+	o.closePosition = true
+	return o
+}
+
+// Params validates the order and returns the parameters for CreateOrder.
+func (o *FuturesOrder) Params() (map[string]interface{}, error) {
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+
+	params := map[string]interface{}{
+		"symbol": o.symbol,
+		"side":   o.side,
+		"type":   o.orderType,
+	}
+	setIfNotEmpty(params, "positionSide", o.positionSide)
+	setIfNotEmpty(params, "quantity", string(o.quantity))
+	setIfNotEmpty(params, "price", string(o.price))
+	setIfNotEmpty(params, "stopPrice", string(o.stopPrice))
+	setIfNotEmpty(params, "priceRate", string(o.priceRate))
+	setIfNotEmpty(params, "timeInForce", o.timeInForce)
+	setIfNotEmpty(params, "workingType", o.workingType)
+	setIfNotEmpty(params, "clientOrderId", o.clientOrderID)
+	if o.reduceOnly {
+		params["reduceOnly"] = "true"
+	}
+	if o.closePosition {
+		params["closePosition"] = "true"
+	}
+	return params, nil
+}
+
+func (o *FuturesOrder) validate() error {
+	if o.symbol == "" {
+		return errors.New("symbol is required")
+	}
+	if o.side == "" {
+		return errors.New("side is required: call Buy or Sell")
+	}
+	if o.orderType == "" {
+		return errors.New("order type is required: call Market, Limit or a trigger type")
+	}
+
+	switch o.orderType {
+	case OrderTypeLimit:
+		if err := requirePositive("price", o.price); err != nil {
+			return err
+		}
+	case OrderTypeStop, OrderTypeTakeProfit, OrderTypeTriggerLimit:
+		if err := requirePositive("price", o.price); err != nil {
+			return err
+		}
+		if err := requirePositive("stopPrice", o.stopPrice); err != nil {
+			return err
+		}
+	case OrderTypeStopMarket, OrderTypeTakeProfitMarket:
+		if err := requirePositive("stopPrice", o.stopPrice); err != nil {
+			return err
+		}
+	case OrderTypeTrailingStopMarket:
+		if err := requirePositive("priceRate", o.priceRate); err != nil {
+			return err
+		}
+		if rate, _ := o.priceRate.Rat(); rate.Cmp(big.NewRat(1, 1)) > 0 {
+			return errors.New("priceRate must not exceed 1")
+		}
+	}
+
+	if o.closePosition {
+		if o.orderType != OrderTypeStopMarket && o.orderType != OrderTypeTakeProfitMarket {
+			return errors.New("closePosition requires a STOP_MARKET or TAKE_PROFIT_MARKET order")
+		}
+		if o.quantity != "" || o.reduceOnly {
+			return errors.New("closePosition cannot be combined with a quantity or reduceOnly")
+		}
+	} else if err := requirePositive("quantity", o.quantity); err != nil {
+		return err
+	}
+
+	if o.reduceOnly && o.positionSide != "" {
+		return errors.New("reduceOnly is only valid in one-way mode; in hedge mode close with the opposite side and the same position side")
+	}
+	if err := requireOneOf("timeInForce", o.timeInForce, TimeInForceGTC, TimeInForceIOC, TimeInForceFOK, TimeInForcePostOnly); err != nil {
+		return err
+	}
+	return requireOneOf("workingType", o.workingType, WorkingTypeMarkPrice, WorkingTypeContractPrice, WorkingTypeIndexPrice)
+}
+
+// SpotOrder builds a SpotOrderRequest fluently:
+//
+//	req, err := services.NewSpotOrder("BTC-USDT").Buy().Limit("64250.5").Qty("0.001").Request()
+//	order, err := client.SpotTrade().CreateOrderRequest(req)
+type SpotOrder struct {
+	req SpotOrderRequest
+}
+
+// NewSpotOrder starts a spot order for symbol.
+func NewSpotOrder(symbol string) *SpotOrder {
+	return &SpotOrder{req: SpotOrderRequest{Symbol: symbol}}
+}
+
+func (o *SpotOrder) Buy() *SpotOrder {
+	o.req.Side = SpotSideBuy
+	return o
+}
+
+func (o *SpotOrder) Sell() *SpotOrder {
+	o.req.Side = SpotSideSell
+	return o
+}
+
+func (o *SpotOrder) Market() *SpotOrder {
+	o.req.Type = SpotOrderTypeMarket
+	o.req.Price = nil
+	return o
+}
+
+func (o *SpotOrder) Limit(price Decimal) *SpotOrder {
+	o.req.Type = SpotOrderTypeLimit
+	value := string(price)
+	o.req.Price = &value
+	return o
+}
+
+func (o *SpotOrder) Qty(quantity Decimal) *SpotOrder {
+	o.req.Quantity = string(quantity)
+	return o
+}
+
+// QuoteQty spends a quote asset amount on a MARKET order instead of a fixed
+// base quantity.
+func (o *SpotOrder) QuoteQty(amount Decimal) *SpotOrder {
+	value := string(amount)
+	o.req.QuoteOrderQty = &value
+	return o
+}
+
+// TimeInForce sets one of the SpotTimeInForce constants.
+func (o *SpotOrder) TimeInForce(timeInForce string) *SpotOrder {
+	o.req.TimeInForce = &timeInForce
+	return o
+}
+
+func (o *SpotOrder) ClientOrderID(id string) *SpotOrder {
+	o.req.ClientOrderID = &id
+	return o
+}
+
+// Request validates the order with the same rules as CreateOrderRequest.
+func (o *SpotOrder) Request() (SpotOrderRequest, error) {
+	if err := validateSpotOrderRequest(o.req); err != nil {
+		return SpotOrderRequest{}, err
+	}
+	if o.req.TimeInForce != nil {
+		if err := requireOneOf("timeInForce", *o.req.TimeInForce, SpotTimeInForceGTC, SpotTimeInForceIOC, SpotTimeInForceFOK, SpotTimeInForcePostOnly); err != nil {
+			return SpotOrderRequest{}, err
+		}
+	}
+	return o.req, nil
+}
+
+// Params validates the order and returns the parameters for
+// SpotTradeService.CreateOrder.
+func (o *SpotOrder) Params() (map[string]interface{}, error) {
+	req, err := o.Request()
+	if err != nil {
+		return nil, err
+	}
+	return spotOrderRequestToParams(req), nil
+}
+
+// TWAPOrder builds the parameters of TradeService.PlaceTWAPOrder. BingX
+// splits TotalAmount into slices of PerOrder, one every Interval, each
+// priced within the variance of the trigger price:
+//
+//	params, err := services.NewTWAPOrder("BTC-USDT").Buy().Long().
+//		Total("10").PerOrder("0.5").Interval(30 * time.Second).
+//		PriceVariancePercent("0.1").TriggerPrice("64000").Params()
+type TWAPOrder struct {
+	symbol         string
+	side           string
+	positionSide   string
+	priceType      string
+	priceVariance  Decimal
+	triggerPrice   Decimal
+	interval       time.Duration
+	amountPerOrder Decimal
+	totalAmount    Decimal
+}
+
+// NewTWAPOrder starts a TWAP order for symbol.
+func NewTWAPOrder(symbol string) *TWAPOrder {
+	return &TWAPOrder{symbol: symbol}
+}
+
+func (o *TWAPOrder) Buy() *TWAPOrder {
+	o.side = SideBuy
+	return o
+}
+
+func (o *TWAPOrder) Sell() *TWAPOrder {
+	o.side = SideSell
+	return o
+}
+
+func (o *TWAPOrder) Long() *TWAPOrder {
+	o.positionSide = PositionSideLong
+	return o
+}
+
+func (o *TWAPOrder) Short() *TWAPOrder {
+	o.positionSide = PositionSideShort
+	return o
+}
+
+// Total is the overall quantity to execute.
+func (o *TWAPOrder) Total(amount Decimal) *TWAPOrder {
+	o.totalAmount = amount
+	return o
+}
+
+// PerOrder is the quantity of each slice.
+func (o *TWAPOrder) PerOrder(amount Decimal) *TWAPOrder {
+	o.amountPerOrder = amount
+	return o
+}
+
+// Interval is the delay between slices, in whole seconds from 5s to 120s.
+func (o *TWAPOrder) Interval(interval time.Duration) *TWAPOrder {
+	o.interval = interval
+	return o
+}
+
+// PriceVariance bounds slice prices to triggerPrice ± variance in quote
+// currency.
+func (o *TWAPOrder) PriceVariance(variance Decimal) *TWAPOrder {
+	o.priceType = TWAPPriceTypeConstant
+	o.priceVariance = variance
+	return o
+}
+
+// PriceVariancePercent bounds slice prices to triggerPrice ± percent%.
+func (o *TWAPOrder) PriceVariancePercent(percent Decimal) *TWAPOrder {
+	o.priceType = TWAPPriceTypePercentage
+	o.priceVariance = percent
+	return o
+}
+
+func (o *TWAPOrder) TriggerPrice(price Decimal) *TWAPOrder {
+	o.triggerPrice = price
+	return o
+}
+
+// Params validates the order and returns the parameters for PlaceTWAPOrder.
+func (o *TWAPOrder) Params() (map[string]interface{}, error) {
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"symbol":         o.symbol,
+		"side":           o.side,
+		"positionSide":   o.positionSide,
+		"priceType":      o.priceType,
+		"priceVariance":  string(o.priceVariance),
+		"triggerPrice":   string(o.triggerPrice),
+		"interval":       int64(o.interval / time.Second),
+		"amountPerOrder": string(o.amountPerOrder),
+		"totalAmount":    string(o.totalAmount),
+	}, nil
+}
+
+func (o *TWAPOrder) validate() error {
+	if o.symbol == "" {
+		return errors.New("symbol is required")
+	}
+	if o.side == "" {
+		return errors.New("side is required: call Buy or Sell")
+	}
+	if o.positionSide == "" {
+		return errors.New("position side is required: call Long or Short")
+	}
+	if err := requirePositive("totalAmount", o.totalAmount); err != nil {
+		return err
+	}
+	if err := requirePositive("amountPerOrder", o.amountPerOrder); err != nil {
+		return err
+	}
+	total, _ := o.totalAmount.Rat()
+	perOrder, _ := o.amountPerOrder.Rat()
+	if perOrder.Cmp(total) > 0 {
+		return errors.New("amountPerOrder must not exceed totalAmount")
+	}
+	if o.interval < 5*time.Second || o.interval > 120*time.Second || o.interval%time.Second != 0 {
+		return fmt.Errorf("interval must be a whole number of seconds between 5s and 120s, got %s", o.interval)
+	}
+	if o.priceType == "" {
+		return errors.New("price variance is required: call PriceVariance or PriceVariancePercent")
+	}
+	if err := requirePositive("priceVariance", o.priceVariance); err != nil {
+		return err
+	}
+	return requirePositive("triggerPrice", o.triggerPrice)
+}
+
+// Universal transfer types, named after the source and destination
+// accounts: FUND is the funding account, SFUTURES standard futures and
+// PFUTURES perpetual futures.
+const (
+	TransferFundToStandardFutures      = "FUND_SFUTURES"
+	TransferStandardFuturesToFund      = "SFUTURES_FUND"
+	TransferFundToPerpetualFutures     = "FUND_PFUTURES"
+	TransferPerpetualFuturesToFund     = "PFUTURES_FUND"
+	TransferStandardToPerpetualFutures = "SFUTURES_PFUTURES"
+	TransferPerpetualToStandardFutures = "PFUTURES_SFUTURES"
+)
+
+// UniversalTransfer builds the parameters of
+// SpotAccountService.UniversalTransferWithParams:
+//
+//	params, err := services.NewUniversalTransfer(services.TransferFundToPerpetualFutures).
+//		Asset("USDT").Amount("250.5").Params()
+type UniversalTransfer struct {
+	transferType string
+	asset        string
+	amount       Decimal
+}
+
+// NewUniversalTransfer starts a transfer of transferType, one of the
+// Transfer constants.
+func NewUniversalTransfer(transferType string) *UniversalTransfer {
+	return &UniversalTransfer{transferType: transferType}
+}
+
+func (t *UniversalTransfer) Asset(asset string) *UniversalTransfer {
+	t.asset = asset
+	return t
+}
+
+func (t *UniversalTransfer) Amount(amount Decimal) *UniversalTransfer {
+	t.amount = amount
+	return t
+}
+
+// Params validates the transfer and returns the parameters for
+// UniversalTransferWithParams.
+func (t *UniversalTransfer) Params() (map[string]interface{}, error) {
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"type":   t.transferType,
+		"asset":  t.asset,
+		"amount": string(t.amount),
+	}, nil
+}
+
+func (t *UniversalTransfer) validate() error {
+	if t.transferType == "" {
+		return errors.New("transfer type is required")
+	}
+	if err := requireOneOf("type", t.transferType,
+		TransferFundToStandardFutures, TransferStandardFuturesToFund,
+		TransferFundToPerpetualFutures, TransferPerpetualFuturesToFund,
+		TransferStandardToPerpetualFutures, TransferPerpetualToStandardFutures); err != nil {
+		return err
+	}
+	if t.asset == "" {
+		return errors.New("asset is required")
+	}
+	return requirePositive("amount", t.amount)
+}
+
+func requirePositive(name string, value Decimal) error {
+	if value == "" {
+		return fmt.Errorf("%s is required", name)
+	}
+	if r, ok := value.Rat(); !ok || r.Sign() <= 0 {
+		return fmt.Errorf("%s must be a positive decimal, got %q", name, string(value))
+	}
+	return nil
+}
+
+// requireOneOf accepts an empty value, which leaves the exchange default.
+func requireOneOf(name, value string, allowed ...string) error {
+	if value == "" {
+		return nil
+	}
+	for _, a := range allowed {
+		if value == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s must be one of %v, got %q", name, allowed, value)
+}
+
+func setIfNotEmpty(params map[string]interface{}, key, value string) {
+	if value != "" {
+		params[key] = value
+	}
+}
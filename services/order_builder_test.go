@@ -0,0 +1,183 @@
+package services
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFuturesOrder_Params(t *testing.T) {
+	params, err := NewFuturesOrder("BTC-USDT").Sell().Limit("64250.50").Qty("0.010").
+		TimeInForce(TimeInForcePostOnly).ClientOrderID("my-order").ReduceOnly().Params()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]interface{}{
+		"symbol":        "BTC-USDT",
+		"side":          "SELL",
+		"type":          "LIMIT",
+		"price":         "64250.50",
+		"quantity":      "0.010",
+		"timeInForce":   "PostOnly",
+		"clientOrderId": "my-order",
+		"reduceOnly":    "true",
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("Params() = %v, want %v", params, want)
+	}
+
+	params, err = NewFuturesOrder("ETH-USDT").Sell().Long().TakeProfitMarket("4000").
+		ClosePosition().WorkingType(WorkingTypeMarkPrice).Params()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if params["positionSide"] != "LONG" || params["stopPrice"] != "4000" || params["closePosition"] != "true" {
+		t.Errorf("unexpected params %v", params)
+	}
+	if _, ok := params["quantity"]; ok {
+		t.Error("closePosition orders must not send a quantity")
+	}
+}
+
+func TestFuturesOrder_Validation(t *testing.T) {
+	tests := []struct {
+		name  string
+		order *FuturesOrder
+		want  string
+	}{
+		{"missing symbol", NewFuturesOrder("").Buy().Market().Qty("1"), "symbol is required"},
+		{"missing side", NewFuturesOrder("BTC-USDT").Market().Qty("1"), "side is required"},
+		{"missing type", NewFuturesOrder("BTC-USDT").Buy().Qty("1"), "order type is required"},
+		{"missing quantity", NewFuturesOrder("BTC-USDT").Buy().Market(), "quantity is required"},
+		{"zero quantity", NewFuturesOrder("BTC-USDT").Buy().Market().Qty("0"), "quantity must be a positive decimal"},
+		{"malformed price", NewFuturesOrder("BTC-USDT").Buy().Limit("64,250").Qty("1"), "price must be a positive decimal"},
+		{"stop without trigger", NewFuturesOrder("BTC-USDT").Buy().Stop("64000", "").Qty("1"), "stopPrice is required"},
+		{"trailing rate above 1", NewFuturesOrder("BTC-USDT").Sell().TrailingStopMarket("1.5").Qty("1"), "priceRate must not exceed 1"},
+		{"closePosition on limit", NewFuturesOrder("BTC-USDT").Sell().Limit("1").ClosePosition(), "closePosition requires"},
+		{"closePosition with quantity", NewFuturesOrder("BTC-USDT").Sell().StopMarket("1").Qty("1").ClosePosition(), "cannot be combined"},
+		{"reduceOnly in hedge mode", NewFuturesOrder("BTC-USDT").Sell().Short().Market().Qty("1").ReduceOnly(), "one-way mode"},
+		{"unknown time in force", NewFuturesOrder("BTC-USDT").Buy().Limit("1").Qty("1").TimeInForce("GTX"), "timeInForce must be one of"},
+		{"unknown working type", NewFuturesOrder("BTC-USDT").Buy().StopMarket("1").Qty("1").WorkingType("LAST"), "workingType must be one of"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := tt.order.Params()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Params() error = %v, want it to contain %q", err, tt.want)
+			}
+			if params != nil {
+				t.Errorf("expected nil params on error, got %v", params)
+			}
+		})
+	}
+}
+
+func TestSpotOrder(t *testing.T) {
+	params, err := NewSpotOrder("BTC-USDT").Buy().Market().QuoteQty("25.5").ClientOrderID("spot-1").Params()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]interface{}{
+		"symbol":           "BTC-USDT",
+		"side":             "BUY",
+		"type":             "MARKET",
+		"quoteOrderQty":    "25.5",
+		"newClientOrderId": "spot-1",
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("Params() = %v, want %v", params, want)
+	}
+
+	if _, err := NewSpotOrder("BTC-USDT").Sell().Limit("65000").Params(); err == nil {
+		t.Error("expected a LIMIT order without quantity to fail")
+	}
+	if _, err := NewSpotOrder("BTC-USDT").Sell().Limit("65000").Qty("1").TimeInForce("DAY").Request(); err == nil {
+		t.Error("expected an unknown timeInForce to fail")
+	}
+}
+
+func TestTWAPOrder(t *testing.T) {
+	params, err := NewTWAPOrder("BTC-USDT").Buy().Long().Total("10").PerOrder("0.5").
+		Interval(30 * time.Second).PriceVariancePercent("0.1").TriggerPrice("64000").Params()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]interface{}{
+		"symbol":         "BTC-USDT",
+		"side":           "BUY",
+		"positionSide":   "LONG",
+		"priceType":      "percentage",
+		"priceVariance":  "0.1",
+		"triggerPrice":   "64000",
+		"interval":       int64(30),
+		"amountPerOrder": "0.5",
+		"totalAmount":    "10",
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("Params() = %v, want %v", params, want)
+	}
+
+	valid := func() *TWAPOrder {
+		return NewTWAPOrder("BTC-USDT").Sell().Short().Total("1").PerOrder("0.1").
+			Interval(10 * time.Second).PriceVariance("5").TriggerPrice("64000")
+	}
+	tests := []struct {
+		name  string
+		order *TWAPOrder
+		want  string
+	}{
+		{"missing position side", NewTWAPOrder("BTC-USDT").Buy(), "position side is required"},
+		{"slice above total", valid().PerOrder("2"), "must not exceed totalAmount"},
+		{"interval too short", valid().Interval(time.Second), "between 5s and 120s"},
+		{"fractional interval", valid().Interval(7500 * time.Millisecond), "whole number of seconds"},
+		{"missing trigger", valid().TriggerPrice(""), "triggerPrice is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.order.Params(); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Params() error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUniversalTransfer(t *testing.T) {
+	params, err := NewUniversalTransfer(TransferFundToPerpetualFutures).Asset("USDT").Amount("250.50").Params()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]interface{}{
+		"type":   "FUND_PFUTURES",
+		"asset":  "USDT",
+		"amount": "250.50",
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("Params() = %v, want %v", params, want)
+	}
+
+	tests := []struct {
+		name     string
+		transfer *UniversalTransfer
+		want     string
+	}{
+		{"missing type", NewUniversalTransfer("").Asset("USDT").Amount("1"), "transfer type is required"},
+		{"unknown type", NewUniversalTransfer("FUND_SPOT").Asset("USDT").Amount("1"), "type must be one of"},
+		{"missing asset", NewUniversalTransfer(TransferPerpetualFuturesToFund).Amount("1"), "asset is required"},
+		{"missing amount", NewUniversalTransfer(TransferPerpetualFuturesToFund).Asset("USDT"), "amount is required"},
+		{"negative amount", NewUniversalTransfer(TransferPerpetualFuturesToFund).Asset("USDT").Amount("-5"), "amount must be a positive decimal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := tt.transfer.Params()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Params() error = %v, want it to contain %q", err, tt.want)
+			}
+			if params != nil {
+				t.Errorf("expected nil params on error, got %v", params)
+			}
+		})
+	}
+}
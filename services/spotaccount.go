@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 
 	"github.com/tigusigalpa/bingx-go/v2/http"
@@ -35,7 +36,11 @@ func NewSpotAccountService(client *http.BaseHTTPClient) *SpotAccountService {
 }
 
 func (s *SpotAccountService) GetBalance() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/spot/v1/account/balance", nil)
+	return s.GetBalanceContext(context.Background())
+}
+
+func (s *SpotAccountService) GetBalanceContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/spot/v1/account/balance", nil)
 }
 
 // GetAccountOverview returns a cross-wallet overview for the specified
@@ -44,12 +49,16 @@ func (s *SpotAccountService) GetBalance() (map[string]interface{}, error) {
 // Use the AccountType* constants (AccountTypeSpotFund, AccountTypeUSDTMPerp,
 // etc.) for the accountType parameter.
 func (s *SpotAccountService) GetAccountOverview(accountType *string) (map[string]interface{}, error) {
+	return s.GetAccountOverviewContext(context.Background(), accountType)
+}
+
+func (s *SpotAccountService) GetAccountOverviewContext(ctx context.Context, accountType *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 	if accountType != nil {
 		params["accountType"] = *accountType
 	}
 
-	return s.client.Request("GET", "/openApi/account/v1/allAccountBalance", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/account/v1/allAccountBalance", params)
 }
 
 // GetFundBalance is retired.
@@ -62,14 +71,32 @@ func (s *SpotAccountService) GetFundBalance() (map[string]interface{}, error) {
 }
 
 func (s *SpotAccountService) UniversalTransfer(transferType, asset string, amount float64) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/wallets/v1/capital/transfer", map[string]interface{}{
+	return s.UniversalTransferContext(context.Background(), transferType, asset, amount)
+}
+
+func (s *SpotAccountService) UniversalTransferContext(ctx context.Context, transferType, asset string, amount float64) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/wallets/v1/capital/transfer", map[string]interface{}{
 		"type":   transferType,
 		"asset":  asset,
 		"amount": amount,
 	})
 }
 
+// UniversalTransferWithParams sends a transfer built with
+// NewUniversalTransfer, which keeps the exact amount.
+func (s *SpotAccountService) UniversalTransferWithParams(params map[string]interface{}) (map[string]interface{}, error) {
+	return s.UniversalTransferWithParamsContext(context.Background(), params)
+}
+
+func (s *SpotAccountService) UniversalTransferWithParamsContext(ctx context.Context, params map[string]interface{}) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/wallets/v1/capital/transfer", params)
+}
+
 func (s *SpotAccountService) GetAssetTransferRecords(transferType string, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
+	return s.GetAssetTransferRecordsContext(context.Background(), transferType, startTime, endTime, limit)
+}
+
+func (s *SpotAccountService) GetAssetTransferRecordsContext(ctx context.Context, transferType string, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"type":  transferType,
 		"limit": limit,
@@ -82,13 +109,17 @@ func (s *SpotAccountService) GetAssetTransferRecords(transferType string, startT
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/wallets/v1/capital/transfer/records", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/wallets/v1/capital/transfer/records", params)
 }
 
 // InternalTransfer performs main account internal transfer
 // walletType: 1=Fund, 2=Standard Futures, 3=Perpetual Futures, 4=Spot
 // userAccountType: 1=UID, 2=Phone number, 3=Email
 func (s *SpotAccountService) InternalTransfer(coin string, walletType int, amount float64, userAccountType int, userAccount string, callingCode, transferClientID *string, recvWindow *int64) (map[string]interface{}, error) {
+	return s.InternalTransferContext(context.Background(), coin, walletType, amount, userAccountType, userAccount, callingCode, transferClientID, recvWindow)
+}
+
+func (s *SpotAccountService) InternalTransferContext(ctx context.Context, coin string, walletType int, amount float64, userAccountType int, userAccount string, callingCode, transferClientID *string, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"coin":            coin,
 		"walletType":      walletType,
@@ -107,10 +138,14 @@ func (s *SpotAccountService) InternalTransfer(coin string, walletType int, amoun
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("POST", "/openApi/wallets/v1/capital/innerTransfer/apply", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/wallets/v1/capital/innerTransfer/apply", params)
 }
 
 func (s *SpotAccountService) GetInternalTransferRecords(coin string, transferType *string, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
+	return s.GetInternalTransferRecordsContext(context.Background(), coin, transferType, startTime, endTime, limit)
+}
+
+func (s *SpotAccountService) GetInternalTransferRecordsContext(ctx context.Context, coin string, transferType *string, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"coin":  coin,
 		"limit": limit,
@@ -126,13 +161,21 @@ func (s *SpotAccountService) GetInternalTransferRecords(coin string, transferTyp
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/wallets/v1/capital/innerTransfer/records", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/wallets/v1/capital/innerTransfer/records", params)
 }
 
 func (s *SpotAccountService) GetAllAccountBalances() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/spot/v1/account/allBalances", nil)
+	return s.GetAllAccountBalancesContext(context.Background())
+}
+
+func (s *SpotAccountService) GetAllAccountBalancesContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/spot/v1/account/allBalances", nil)
 }
 
 func (s *SpotAccountService) GetAccountType() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/spot/v1/account/type", nil)
+	return s.GetAccountTypeContext(context.Background())
+}
+
+func (s *SpotAccountService) GetAccountTypeContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/spot/v1/account/type", nil)
 }
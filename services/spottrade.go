@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"strconv"
 	"strings"
@@ -77,18 +78,26 @@ func NewSpotTradeService(client *http.BaseHTTPClient) *SpotTradeService {
 //
 // POST /openApi/spot/v1/trade/order
 func (s *SpotTradeService) CreateOrder(params map[string]interface{}) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/spot/v1/trade/order", params)
+	return s.CreateOrderContext(context.Background(), params)
+}
+
+func (s *SpotTradeService) CreateOrderContext(ctx context.Context, params map[string]interface{}) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/spot/v1/trade/order", params)
 }
 
 // CreateOrderRequest places a typed LIMIT or MARKET spot order after
 // validating the request client-side. See SpotOrderRequest for field
 // documentation.
 func (s *SpotTradeService) CreateOrderRequest(req SpotOrderRequest) (map[string]interface{}, error) {
+	return s.CreateOrderRequestContext(context.Background(), req)
+}
+
+func (s *SpotTradeService) CreateOrderRequestContext(ctx context.Context, req SpotOrderRequest) (map[string]interface{}, error) {
 	if err := validateSpotOrderRequest(req); err != nil {
 		return nil, err
 	}
 
-	return s.CreateOrder(spotOrderRequestToParams(req))
+	return s.CreateOrderContext(ctx, spotOrderRequestToParams(req))
 }
 
 // CreateTestOrder is intentionally not implemented.
@@ -107,6 +116,10 @@ func (s *SpotTradeService) CreateOrderRequest(req SpotOrderRequest) (map[string]
 //
 // POST /openApi/spot/v1/trade/cancel
 func (s *SpotTradeService) CancelOrder(symbol string, orderID, clientOrderID *string) (map[string]interface{}, error) {
+	return s.CancelOrderContext(context.Background(), symbol, orderID, clientOrderID)
+}
+
+func (s *SpotTradeService) CancelOrderContext(ctx context.Context, symbol string, orderID, clientOrderID *string) (map[string]interface{}, error) {
 	if symbol == "" {
 		return nil, errors.New("symbol is required")
 	}
@@ -124,7 +137,7 @@ func (s *SpotTradeService) CancelOrder(symbol string, orderID, clientOrderID *st
 		params["clientOrderID"] = *clientOrderID
 	}
 
-	return s.client.Request("POST", "/openApi/spot/v1/trade/cancel", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/spot/v1/trade/cancel", params)
 }
 
 // CancelAllOrders cancels every open spot order for the given symbol. If
@@ -132,12 +145,16 @@ func (s *SpotTradeService) CancelOrder(symbol string, orderID, clientOrderID *st
 //
 // POST /openApi/spot/v1/trade/cancelOpenOrders
 func (s *SpotTradeService) CancelAllOrders(symbol *string) (map[string]interface{}, error) {
+	return s.CancelAllOrdersContext(context.Background(), symbol)
+}
+
+func (s *SpotTradeService) CancelAllOrdersContext(ctx context.Context, symbol *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 	if symbol != nil {
 		params["symbol"] = *symbol
 	}
 
-	return s.client.Request("POST", "/openApi/spot/v1/trade/cancelOpenOrders", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/spot/v1/trade/cancelOpenOrders", params)
 }
 
 // CancelBatchOrders cancels a batch of spot orders identified by orderIDs
@@ -145,6 +162,10 @@ func (s *SpotTradeService) CancelAllOrders(symbol *string) (map[string]interface
 //
 // POST /openApi/spot/v1/trade/cancelOrders
 func (s *SpotTradeService) CancelBatchOrders(symbol string, orderIDs, clientOrderIDs []string) (map[string]interface{}, error) {
+	return s.CancelBatchOrdersContext(context.Background(), symbol, orderIDs, clientOrderIDs)
+}
+
+func (s *SpotTradeService) CancelBatchOrdersContext(ctx context.Context, symbol string, orderIDs, clientOrderIDs []string) (map[string]interface{}, error) {
 	if symbol == "" {
 		return nil, errors.New("symbol is required")
 	}
@@ -162,7 +183,7 @@ func (s *SpotTradeService) CancelBatchOrders(symbol string, orderIDs, clientOrde
 		params["clientOrderIDs"] = strings.Join(clientOrderIDs, ",")
 	}
 
-	return s.client.Request("POST", "/openApi/spot/v1/trade/cancelOrders", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/spot/v1/trade/cancelOrders", params)
 }
 
 // AmendOrder amends an existing spot order using BingX's native
@@ -185,6 +206,10 @@ func (s *SpotTradeService) CancelBatchOrders(symbol string, orderIDs, clientOrde
 //
 // POST /openApi/spot/v1/trade/order/cancelReplace
 func (s *SpotTradeService) AmendOrder(symbol string, cancelOrderID, cancelClientOrderID *string, cancelReplaceMode string, newOrder SpotOrderRequest) (map[string]interface{}, error) {
+	return s.AmendOrderContext(context.Background(), symbol, cancelOrderID, cancelClientOrderID, cancelReplaceMode, newOrder)
+}
+
+func (s *SpotTradeService) AmendOrderContext(ctx context.Context, symbol string, cancelOrderID, cancelClientOrderID *string, cancelReplaceMode string, newOrder SpotOrderRequest) (map[string]interface{}, error) {
 	if symbol == "" {
 		return nil, errors.New("symbol is required")
 	}
@@ -211,7 +236,7 @@ func (s *SpotTradeService) AmendOrder(symbol string, cancelOrderID, cancelClient
 		params["cancelClientOrderID"] = *cancelClientOrderID
 	}
 
-	return s.client.Request("POST", "/openApi/spot/v1/trade/order/cancelReplace", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/spot/v1/trade/order/cancelReplace", params)
 }
 
 // GetOrder queries a single spot order. Exactly one of orderID or
@@ -219,6 +244,10 @@ func (s *SpotTradeService) AmendOrder(symbol string, cancelOrderID, cancelClient
 //
 // GET /openApi/spot/v1/trade/query
 func (s *SpotTradeService) GetOrder(symbol string, orderID, clientOrderID *string) (map[string]interface{}, error) {
+	return s.GetOrderContext(context.Background(), symbol, orderID, clientOrderID)
+}
+
+func (s *SpotTradeService) GetOrderContext(ctx context.Context, symbol string, orderID, clientOrderID *string) (map[string]interface{}, error) {
 	if symbol == "" {
 		return nil, errors.New("symbol is required")
 	}
@@ -236,7 +265,7 @@ func (s *SpotTradeService) GetOrder(symbol string, orderID, clientOrderID *strin
 		params["clientOrderID"] = *clientOrderID
 	}
 
-	return s.client.Request("GET", "/openApi/spot/v1/trade/query", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/spot/v1/trade/query", params)
 }
 
 // GetOpenOrders returns current open spot orders. If symbol is nil, open
@@ -248,12 +277,16 @@ func (s *SpotTradeService) GetOrder(symbol string, orderID, clientOrderID *strin
 //
 // GET /openApi/spot/v1/trade/openOrders
 func (s *SpotTradeService) GetOpenOrders(symbol *string) (map[string]interface{}, error) {
+	return s.GetOpenOrdersContext(context.Background(), symbol)
+}
+
+func (s *SpotTradeService) GetOpenOrdersContext(ctx context.Context, symbol *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 	if symbol != nil {
 		params["symbol"] = *symbol
 	}
 
-	return s.client.Request("GET", "/openApi/spot/v1/trade/openOrders", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/spot/v1/trade/openOrders", params)
 }
 
 // GetOrderHistory returns historical spot orders. limit is sent as the
@@ -261,6 +294,10 @@ func (s *SpotTradeService) GetOpenOrders(symbol *string) (map[string]interface{}
 //
 // GET /openApi/spot/v1/trade/historyOrders
 func (s *SpotTradeService) GetOrderHistory(symbol *string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetOrderHistoryContext(context.Background(), symbol, limit, startTime, endTime)
+}
+
+func (s *SpotTradeService) GetOrderHistoryContext(ctx context.Context, symbol *string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 	if symbol != nil {
 		params["symbol"] = *symbol
@@ -275,7 +312,7 @@ func (s *SpotTradeService) GetOrderHistory(symbol *string, limit int, startTime,
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/spot/v1/trade/historyOrders", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/spot/v1/trade/historyOrders", params)
 }
 
 // GetTrades returns filled trade details for a symbol. The BingX Spot API
@@ -284,6 +321,10 @@ func (s *SpotTradeService) GetOrderHistory(symbol *string, limit int, startTime,
 //
 // GET /openApi/spot/v1/trade/myTrades
 func (s *SpotTradeService) GetTrades(symbol *string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetTradesContext(context.Background(), symbol, limit, startTime, endTime)
+}
+
+func (s *SpotTradeService) GetTradesContext(ctx context.Context, symbol *string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	if symbol == nil || *symbol == "" {
 		return nil, errors.New("symbol is required")
 	}
@@ -301,7 +342,7 @@ func (s *SpotTradeService) GetTrades(symbol *string, limit int, startTime, endTi
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/spot/v1/trade/myTrades", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/spot/v1/trade/myTrades", params)
 }
 
 // spotOrderRequestToParams converts a validated SpotOrderRequest into raw
@@ -1,6 +1,10 @@
 package services
 
-import "github.com/tigusigalpa/bingx-go/v2/http"
+import (
+	"context"
+
+	"github.com/tigusigalpa/bingx-go/v2/http"
+)
 
 // Sub-account wallet type constants
 const (
@@ -19,16 +23,28 @@ func NewSubAccountService(client *http.BaseHTTPClient) *SubAccountService {
 }
 
 func (s *SubAccountService) CreateSubAccount(subAccountString string) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/subAccount/v1/create", map[string]interface{}{
+	return s.CreateSubAccountContext(context.Background(), subAccountString)
+}
+
+func (s *SubAccountService) CreateSubAccountContext(ctx context.Context, subAccountString string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/subAccount/v1/create", map[string]interface{}{
 		"subAccountString": subAccountString,
 	})
 }
 
 func (s *SubAccountService) GetAccountUID() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/subAccount/v1/uid", nil)
+	return s.GetAccountUIDContext(context.Background())
+}
+
+func (s *SubAccountService) GetAccountUIDContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/subAccount/v1/uid", nil)
 }
 
 func (s *SubAccountService) GetSubAccountList(subAccountString *string, current, size int) (map[string]interface{}, error) {
+	return s.GetSubAccountListContext(context.Background(), subAccountString, current, size)
+}
+
+func (s *SubAccountService) GetSubAccountListContext(ctx context.Context, subAccountString *string, current, size int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"current": current,
 		"size":    size,
@@ -38,27 +54,43 @@ func (s *SubAccountService) GetSubAccountList(subAccountString *string, current,
 		params["subAccountString"] = *subAccountString
 	}
 
-	return s.client.Request("GET", "/openApi/subAccount/v1/list", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/subAccount/v1/list", params)
 }
 
 func (s *SubAccountService) GetSubAccountAssets(subUID string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/subAccount/v1/assets", map[string]interface{}{
+	return s.GetSubAccountAssetsContext(context.Background(), subUID)
+}
+
+func (s *SubAccountService) GetSubAccountAssetsContext(ctx context.Context, subUID string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/subAccount/v1/assets", map[string]interface{}{
 		"subUid": subUID,
 	})
 }
 
 func (s *SubAccountService) UpdateSubAccountStatus(subAccountString string, status int) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/subAccount/v1/status", map[string]interface{}{
+	return s.UpdateSubAccountStatusContext(context.Background(), subAccountString, status)
+}
+
+func (s *SubAccountService) UpdateSubAccountStatusContext(ctx context.Context, subAccountString string, status int) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/subAccount/v1/status", map[string]interface{}{
 		"subAccountString": subAccountString,
 		"status":           status,
 	})
 }
 
 func (s *SubAccountService) GetAllSubAccountBalances() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/subAccount/v1/allBalances", nil)
+	return s.GetAllSubAccountBalancesContext(context.Background())
+}
+
+func (s *SubAccountService) GetAllSubAccountBalancesContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/subAccount/v1/allBalances", nil)
 }
 
 func (s *SubAccountService) CreateSubAccountAPIKey(subAccountString, label string, permissions map[string]bool, ip *string) (map[string]interface{}, error) {
+	return s.CreateSubAccountAPIKeyContext(context.Background(), subAccountString, label, permissions, ip)
+}
+
+func (s *SubAccountService) CreateSubAccountAPIKeyContext(ctx context.Context, subAccountString, label string, permissions map[string]bool, ip *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"subAccountString": subAccountString,
 		"label":            label,
@@ -69,16 +101,24 @@ func (s *SubAccountService) CreateSubAccountAPIKey(subAccountString, label strin
 		params["ip"] = *ip
 	}
 
-	return s.client.Request("POST", "/openApi/subAccount/v1/apiKey/create", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/subAccount/v1/apiKey/create", params)
 }
 
 func (s *SubAccountService) QueryAPIKey(subAccountString string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/subAccount/v1/apiKey/query", map[string]interface{}{
+	return s.QueryAPIKeyContext(context.Background(), subAccountString)
+}
+
+func (s *SubAccountService) QueryAPIKeyContext(ctx context.Context, subAccountString string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/subAccount/v1/apiKey/query", map[string]interface{}{
 		"subAccountString": subAccountString,
 	})
 }
 
 func (s *SubAccountService) EditSubAccountAPIKey(subAccountString, apiKey string, permissions map[string]bool, ip *string) (map[string]interface{}, error) {
+	return s.EditSubAccountAPIKeyContext(context.Background(), subAccountString, apiKey, permissions, ip)
+}
+
+func (s *SubAccountService) EditSubAccountAPIKeyContext(ctx context.Context, subAccountString, apiKey string, permissions map[string]bool, ip *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"subAccountString": subAccountString,
 		"apiKey":           apiKey,
@@ -89,18 +129,26 @@ func (s *SubAccountService) EditSubAccountAPIKey(subAccountString, apiKey string
 		params["ip"] = *ip
 	}
 
-	return s.client.Request("POST", "/openApi/subAccount/v1/apiKey/edit", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/subAccount/v1/apiKey/edit", params)
 }
 
 func (s *SubAccountService) DeleteSubAccountAPIKey(subAccountString, apiKey string) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/subAccount/v1/apiKey/delete", map[string]interface{}{
+	return s.DeleteSubAccountAPIKeyContext(context.Background(), subAccountString, apiKey)
+}
+
+func (s *SubAccountService) DeleteSubAccountAPIKeyContext(ctx context.Context, subAccountString, apiKey string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/subAccount/v1/apiKey/delete", map[string]interface{}{
 		"subAccountString": subAccountString,
 		"apiKey":           apiKey,
 	})
 }
 
 func (s *SubAccountService) AuthorizeSubAccountInternalTransfer(subAccountString string, authorize int) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/subAccount/v1/innerTransfer/authorize", map[string]interface{}{
+	return s.AuthorizeSubAccountInternalTransferContext(context.Background(), subAccountString, authorize)
+}
+
+func (s *SubAccountService) AuthorizeSubAccountInternalTransferContext(ctx context.Context, subAccountString string, authorize int) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/subAccount/v1/innerTransfer/authorize", map[string]interface{}{
 		"subAccountString": subAccountString,
 		"authorize":        authorize,
 	})
@@ -110,6 +158,10 @@ func (s *SubAccountService) AuthorizeSubAccountInternalTransfer(subAccountString
 // walletType: 1=Fund, 2=Standard Futures, 3=Perpetual Futures, 15=Spot
 // userAccountType: 1=UID, 2=Phone number, 3=Email
 func (s *SubAccountService) SubAccountInternalTransfer(coin string, walletType int, amount float64, userAccountType int, userAccount string, callingCode, transferClientID *string, recvWindow *int64) (map[string]interface{}, error) {
+	return s.SubAccountInternalTransferContext(context.Background(), coin, walletType, amount, userAccountType, userAccount, callingCode, transferClientID, recvWindow)
+}
+
+func (s *SubAccountService) SubAccountInternalTransferContext(ctx context.Context, coin string, walletType int, amount float64, userAccountType int, userAccount string, callingCode, transferClientID *string, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"coin":            coin,
 		"walletType":      walletType,
@@ -128,10 +180,14 @@ func (s *SubAccountService) SubAccountInternalTransfer(coin string, walletType i
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("POST", "/openApi/wallets/v1/capital/subAccountInnerTransfer/apply", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/wallets/v1/capital/subAccountInnerTransfer/apply", params)
 }
 
 func (s *SubAccountService) GetSubAccountInternalTransferRecords(startTime, endTime *int64, current, size int) (map[string]interface{}, error) {
+	return s.GetSubAccountInternalTransferRecordsContext(context.Background(), startTime, endTime, current, size)
+}
+
+func (s *SubAccountService) GetSubAccountInternalTransferRecordsContext(ctx context.Context, startTime, endTime *int64, current, size int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"current": current,
 		"size":    size,
@@ -144,11 +200,15 @@ func (s *SubAccountService) GetSubAccountInternalTransferRecords(startTime, endT
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/subAccount/v1/innerTransfer/records", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/subAccount/v1/innerTransfer/records", params)
 }
 
 func (s *SubAccountService) SubAccountAssetTransfer(subUID, transferType, asset string, amount float64) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/subAccount/v1/transfer", map[string]interface{}{
+	return s.SubAccountAssetTransferContext(context.Background(), subUID, transferType, asset, amount)
+}
+
+func (s *SubAccountService) SubAccountAssetTransferContext(ctx context.Context, subUID, transferType, asset string, amount float64) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/subAccount/v1/transfer", map[string]interface{}{
 		"subUid": subUID,
 		"type":   transferType,
 		"asset":  asset,
@@ -157,12 +217,20 @@ func (s *SubAccountService) SubAccountAssetTransfer(subUID, transferType, asset
 }
 
 func (s *SubAccountService) GetSubAccountTransferSupportedCoins(subUID string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/subAccount/v1/transfer/supportCoins", map[string]interface{}{
+	return s.GetSubAccountTransferSupportedCoinsContext(context.Background(), subUID)
+}
+
+func (s *SubAccountService) GetSubAccountTransferSupportedCoinsContext(ctx context.Context, subUID string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/subAccount/v1/transfer/supportCoins", map[string]interface{}{
 		"subUid": subUID,
 	})
 }
 
 func (s *SubAccountService) GetSubAccountAssetTransferHistory(subUID, transferType string, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
+	return s.GetSubAccountAssetTransferHistoryContext(context.Background(), subUID, transferType, startTime, endTime, limit)
+}
+
+func (s *SubAccountService) GetSubAccountAssetTransferHistoryContext(ctx context.Context, subUID, transferType string, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"subUid": subUID,
 		"type":   transferType,
@@ -176,11 +244,15 @@ func (s *SubAccountService) GetSubAccountAssetTransferHistory(subUID, transferTy
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/subAccount/v1/transfer/history", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/subAccount/v1/transfer/history", params)
 }
 
 func (s *SubAccountService) CreateSubAccountDepositAddress(coin, network, subUID string) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/subAccount/v1/capital/deposit/address", map[string]interface{}{
+	return s.CreateSubAccountDepositAddressContext(context.Background(), coin, network, subUID)
+}
+
+func (s *SubAccountService) CreateSubAccountDepositAddressContext(ctx context.Context, coin, network, subUID string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/subAccount/v1/capital/deposit/address", map[string]interface{}{
 		"coin":    coin,
 		"network": network,
 		"subUid":  subUID,
@@ -188,6 +260,10 @@ func (s *SubAccountService) CreateSubAccountDepositAddress(coin, network, subUID
 }
 
 func (s *SubAccountService) GetSubAccountDepositAddress(coin, subUID string, network *string) (map[string]interface{}, error) {
+	return s.GetSubAccountDepositAddressContext(context.Background(), coin, subUID, network)
+}
+
+func (s *SubAccountService) GetSubAccountDepositAddressContext(ctx context.Context, coin, subUID string, network *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"coin":   coin,
 		"subUid": subUID,
@@ -197,10 +273,14 @@ func (s *SubAccountService) GetSubAccountDepositAddress(coin, subUID string, net
 		params["network"] = *network
 	}
 
-	return s.client.Request("GET", "/openApi/subAccount/v1/capital/deposit/address", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/subAccount/v1/capital/deposit/address", params)
 }
 
 func (s *SubAccountService) GetSubAccountDepositHistory(subUID, coin string, status *int, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
+	return s.GetSubAccountDepositHistoryContext(context.Background(), subUID, coin, status, startTime, endTime, limit)
+}
+
+func (s *SubAccountService) GetSubAccountDepositHistoryContext(ctx context.Context, subUID, coin string, status *int, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"subUid": subUID,
 		"coin":   coin,
@@ -217,13 +297,17 @@ func (s *SubAccountService) GetSubAccountDepositHistory(subUID, coin string, sta
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/subAccount/v1/capital/deposit/history", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/subAccount/v1/capital/deposit/history", params)
 }
 
 // SubMotherAccountAssetTransfer performs asset transfer between parent and sub-accounts
 // Note: This endpoint is only available to the master account
 // fromAccountType/toAccountType: 1=Funding, 2=Standard futures, 3=Perpetual U-based, 15=Spot
 func (s *SubAccountService) SubMotherAccountAssetTransfer(assetName string, transferAmount float64, fromUID int64, fromType int, fromAccountType int, toUID int64, toType int, toAccountType int, remark string, recvWindow *int64) (map[string]interface{}, error) {
+	return s.SubMotherAccountAssetTransferContext(context.Background(), assetName, transferAmount, fromUID, fromType, fromAccountType, toUID, toType, toAccountType, remark, recvWindow)
+}
+
+func (s *SubAccountService) SubMotherAccountAssetTransferContext(ctx context.Context, assetName string, transferAmount float64, fromUID int64, fromType int, fromAccountType int, toUID int64, toType int, toAccountType int, remark string, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"assetName":       assetName,
 		"transferAmount":  transferAmount,
@@ -240,12 +324,16 @@ func (s *SubAccountService) SubMotherAccountAssetTransfer(assetName string, tran
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("POST", "/openApi/account/transfer/v1/subAccount/transferAsset", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/account/transfer/v1/subAccount/transferAsset", params)
 }
 
 // GetSubMotherAccountTransferableAmount queries supported coins and available transferable amounts
 // Note: This endpoint is only available to the master account
 func (s *SubAccountService) GetSubMotherAccountTransferableAmount(fromUID int64, fromAccountType int, toUID int64, toAccountType int, recvWindow *int64) (map[string]interface{}, error) {
+	return s.GetSubMotherAccountTransferableAmountContext(context.Background(), fromUID, fromAccountType, toUID, toAccountType, recvWindow)
+}
+
+func (s *SubAccountService) GetSubMotherAccountTransferableAmountContext(ctx context.Context, fromUID int64, fromAccountType int, toUID int64, toAccountType int, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"fromUid":         fromUID,
 		"fromAccountType": fromAccountType,
@@ -257,12 +345,16 @@ func (s *SubAccountService) GetSubMotherAccountTransferableAmount(fromUID int64,
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("POST", "/openApi/account/transfer/v1/subAccount/transferAsset/supportCoins", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/account/transfer/v1/subAccount/transferAsset/supportCoins", params)
 }
 
 // GetSubMotherAccountTransferHistory queries transfer history between sub-accounts and parent account
 // Note: This endpoint is only available to the master account
 func (s *SubAccountService) GetSubMotherAccountTransferHistory(uid int64, transferType, tranID *string, startTime, endTime *int64, pageID, pagingSize *int, recvWindow *int64) (map[string]interface{}, error) {
+	return s.GetSubMotherAccountTransferHistoryContext(context.Background(), uid, transferType, tranID, startTime, endTime, pageID, pagingSize, recvWindow)
+}
+
+func (s *SubAccountService) GetSubMotherAccountTransferHistoryContext(ctx context.Context, uid int64, transferType, tranID *string, startTime, endTime *int64, pageID, pagingSize *int, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"uid": uid,
 	}
@@ -289,5 +381,5 @@ func (s *SubAccountService) GetSubMotherAccountTransferHistory(uid int64, transf
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/account/transfer/v1/subAccount/asset/transferHistory", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/account/transfer/v1/subAccount/asset/transferHistory", params)
 }
@@ -1,8 +1,10 @@
 package services
 
 import (
+	"context"
 	"errors"
-	"time"
+	"math"
+	"math/big"
 
 	"github.com/tigusigalpa/bingx-go/v2/http"
 )
@@ -46,30 +48,54 @@ func (s *TradeService) CalculateFuturesCommission(margin float64, leverage int,
 		rate = *commissionRate
 	}
 
-	positionValue := margin * float64(leverage)
-	commission := positionValue * rate
+	result := CommissionResult{
+		Margin:         margin,
+		Leverage:       leverage,
+		CommissionRate: rate,
+	}
 
-	return CommissionResult{
-		Margin:                margin,
-		Leverage:              leverage,
-		PositionValue:         positionValue,
-		CommissionRate:        rate,
-		CommissionRatePercent: rate * 100,
-		Commission:            commission,
-		CommissionRounded:     float64(int(commission*1000000)) / 1000000,
-		NetPositionValue:      positionValue - commission,
+	// Compute on the exact decimal inputs so that values such as 0.1 * 3 are
+	// not skewed by float64 representation errors. NaN and infinite inputs
+	// have no exact value and fall back to float64 arithmetic.
+	exactMargin, okMargin := DecimalFromFloat(margin).Rat()
+	exactRate, okRate := DecimalFromFloat(rate).Rat()
+	if !okMargin || !okRate {
+		result.PositionValue = margin * float64(leverage)
+		result.CommissionRatePercent = rate * 100
+		result.Commission = result.PositionValue * rate
+		result.CommissionRounded = math.Trunc(result.Commission*1000000) / 1000000
+		result.NetPositionValue = result.PositionValue - result.Commission
+		return result
 	}
+	positionValue := new(big.Rat).Mul(exactMargin, new(big.Rat).SetInt64(int64(leverage)))
+	commission := new(big.Rat).Mul(positionValue, exactRate)
+	micros := new(big.Int).Quo(new(big.Int).Mul(commission.Num(), big.NewInt(1000000)), commission.Denom())
+
+	result.PositionValue, _ = positionValue.Float64()
+	result.CommissionRatePercent, _ = new(big.Rat).Mul(exactRate, big.NewRat(100, 1)).Float64()
+	result.Commission, _ = commission.Float64()
+	result.CommissionRounded, _ = new(big.Rat).SetFrac(micros, big.NewInt(1000000)).Float64()
+	result.NetPositionValue, _ = new(big.Rat).Sub(positionValue, commission).Float64()
+	return result
 }
 
 func (s *TradeService) GetCommissionAmount(margin float64, leverage int) float64 {
-	return margin * float64(leverage) * FuturesCommissionRate
+	return s.CalculateFuturesCommission(margin, leverage, nil).Commission
 }
 
 func (s *TradeService) CreateOrder(params map[string]interface{}) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/trade/order", params)
+	return s.CreateOrderContext(context.Background(), params)
+}
+
+func (s *TradeService) CreateOrderContext(ctx context.Context, params map[string]interface{}) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/order", params)
 }
 
 func (s *TradeService) ModifyOrder(symbol string, quantity float64, orderID, clientOrderID *string, timestamp, recvWindow *int64) (map[string]interface{}, error) {
+	return s.ModifyOrderContext(context.Background(), symbol, quantity, orderID, clientOrderID, timestamp, recvWindow)
+}
+
+func (s *TradeService) ModifyOrderContext(ctx context.Context, symbol string, quantity float64, orderID, clientOrderID *string, timestamp, recvWindow *int64) (map[string]interface{}, error) {
 	if orderID == nil && clientOrderID == nil {
 		return nil, errors.New("modifyOrder requires either orderID or clientOrderID")
 	}
@@ -85,8 +111,6 @@ func (s *TradeService) ModifyOrder(symbol string, quantity float64, orderID, cli
 
 	if timestamp != nil {
 		params["timestamp"] = *timestamp
-	} else {
-		params["timestamp"] = time.Now().UnixMilli()
 	}
 
 	if orderID != nil {
@@ -101,58 +125,62 @@ func (s *TradeService) ModifyOrder(symbol string, quantity float64, orderID, cli
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("POST", "/openApi/swap/v1/trade/amend", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v1/trade/amend", params)
 }
 
 func (s *TradeService) CreateTestOrder(params map[string]interface{}) (map[string]interface{}, error) {
-	if params == nil {
-		params = make(map[string]interface{})
-	}
-
-	if _, exists := params["timestamp"]; !exists {
-		params["timestamp"] = time.Now().UnixMilli()
-	}
+	return s.CreateTestOrderContext(context.Background(), params)
+}
 
-	return s.client.Request("POST", "/openApi/swap/v2/trade/order/test", params)
+func (s *TradeService) CreateTestOrderContext(ctx context.Context, params map[string]interface{}) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/order/test", params)
 }
 
 func (s *TradeService) CloseAllPositions(symbol string, timestamp, recvWindow *int64) (map[string]interface{}, error) {
+	return s.CloseAllPositionsContext(context.Background(), symbol, timestamp, recvWindow)
+}
+
+func (s *TradeService) CloseAllPositionsContext(ctx context.Context, symbol string, timestamp, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 	}
 
 	if timestamp != nil {
 		params["timestamp"] = *timestamp
-	} else {
-		params["timestamp"] = time.Now().UnixMilli()
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("POST", "/openApi/swap/v2/trade/closeAllPositions", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/closeAllPositions", params)
 }
 
 func (s *TradeService) GetMarginType(symbol string, timestamp, recvWindow *int64) (map[string]interface{}, error) {
+	return s.GetMarginTypeContext(context.Background(), symbol, timestamp, recvWindow)
+}
+
+func (s *TradeService) GetMarginTypeContext(ctx context.Context, symbol string, timestamp, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 	}
 
 	if timestamp != nil {
 		params["timestamp"] = *timestamp
-	} else {
-		params["timestamp"] = time.Now().UnixMilli()
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/marginType", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/marginType", params)
 }
 
 func (s *TradeService) ChangeMarginType(symbol, marginType string, timestamp, recvWindow *int64) (map[string]interface{}, error) {
+	return s.ChangeMarginTypeContext(context.Background(), symbol, marginType, timestamp, recvWindow)
+}
+
+func (s *TradeService) ChangeMarginTypeContext(ctx context.Context, symbol, marginType string, timestamp, recvWindow *int64) (map[string]interface{}, error) {
 	if marginType != "ISOLATED" && marginType != "CROSSED" {
 		return nil, errors.New("margin type must be ISOLATED or CROSSED")
 	}
@@ -164,36 +192,40 @@ func (s *TradeService) ChangeMarginType(symbol, marginType string, timestamp, re
 
 	if timestamp != nil {
 		params["timestamp"] = *timestamp
-	} else {
-		params["timestamp"] = time.Now().UnixMilli()
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("POST", "/openApi/swap/v2/trade/marginType", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/marginType", params)
 }
 
 func (s *TradeService) GetLeverage(symbol string, timestamp, recvWindow *int64) (map[string]interface{}, error) {
+	return s.GetLeverageContext(context.Background(), symbol, timestamp, recvWindow)
+}
+
+func (s *TradeService) GetLeverageContext(ctx context.Context, symbol string, timestamp, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 	}
 
 	if timestamp != nil {
 		params["timestamp"] = *timestamp
-	} else {
-		params["timestamp"] = time.Now().UnixMilli()
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/leverage", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/leverage", params)
 }
 
 func (s *TradeService) SetLeverage(symbol string, leverage int, timestamp, recvWindow *int64) (map[string]interface{}, error) {
+	return s.SetLeverageContext(context.Background(), symbol, leverage, timestamp, recvWindow)
+}
+
+func (s *TradeService) SetLeverageContext(ctx context.Context, symbol string, leverage int, timestamp, recvWindow *int64) (map[string]interface{}, error) {
 	if leverage < 1 || leverage > 125 {
 		return nil, errors.New("leverage must be between 1 and 125")
 	}
@@ -205,32 +237,36 @@ func (s *TradeService) SetLeverage(symbol string, leverage int, timestamp, recvW
 
 	if timestamp != nil {
 		params["timestamp"] = *timestamp
-	} else {
-		params["timestamp"] = time.Now().UnixMilli()
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("POST", "/openApi/swap/v2/trade/leverage", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/leverage", params)
 }
 
 func (s *TradeService) CreateBatchOrders(orders []map[string]interface{}) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/trade/batchOrders", map[string]interface{}{
+	return s.CreateBatchOrdersContext(context.Background(), orders)
+}
+
+func (s *TradeService) CreateBatchOrdersContext(ctx context.Context, orders []map[string]interface{}) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/batchOrders", map[string]interface{}{
 		"orders": orders,
 	})
 }
 
 func (s *TradeService) CancelOrder(symbol string, orderID, clientOrderID *string, timestamp, recvWindow *int64) (map[string]interface{}, error) {
+	return s.CancelOrderContext(context.Background(), symbol, orderID, clientOrderID, timestamp, recvWindow)
+}
+
+func (s *TradeService) CancelOrderContext(ctx context.Context, symbol string, orderID, clientOrderID *string, timestamp, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 	}
 
 	if timestamp != nil {
 		params["timestamp"] = *timestamp
-	} else {
-		params["timestamp"] = time.Now().UnixMilli()
 	}
 
 	if orderID != nil {
@@ -245,16 +281,18 @@ func (s *TradeService) CancelOrder(symbol string, orderID, clientOrderID *string
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("DELETE", "/openApi/swap/v2/trade/order", params)
+	return s.client.RequestContext(ctx, "DELETE", "/openApi/swap/v2/trade/order", params)
 }
 
 func (s *TradeService) CancelAllOrders(timestamp *int64, symbol, orderType *string, recvWindow *int64) (map[string]interface{}, error) {
+	return s.CancelAllOrdersContext(context.Background(), timestamp, symbol, orderType, recvWindow)
+}
+
+func (s *TradeService) CancelAllOrdersContext(ctx context.Context, timestamp *int64, symbol, orderType *string, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 
 	if timestamp != nil {
 		params["timestamp"] = *timestamp
-	} else {
-		params["timestamp"] = time.Now().UnixMilli()
 	}
 
 	if symbol != nil {
@@ -269,18 +307,20 @@ func (s *TradeService) CancelAllOrders(timestamp *int64, symbol, orderType *stri
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("DELETE", "/openApi/swap/v2/trade/allOpenOrders", params)
+	return s.client.RequestContext(ctx, "DELETE", "/openApi/swap/v2/trade/allOpenOrders", params)
 }
 
 func (s *TradeService) CancelBatchOrders(symbol string, orderIDs []string, clientOrderIDs []string, timestamp, recvWindow *int64) (map[string]interface{}, error) {
+	return s.CancelBatchOrdersContext(context.Background(), symbol, orderIDs, clientOrderIDs, timestamp, recvWindow)
+}
+
+func (s *TradeService) CancelBatchOrdersContext(ctx context.Context, symbol string, orderIDs []string, clientOrderIDs []string, timestamp, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 	}
 
 	if timestamp != nil {
 		params["timestamp"] = *timestamp
-	} else {
-		params["timestamp"] = time.Now().UnixMilli()
 	}
 
 	if len(orderIDs) > 0 {
@@ -309,17 +349,25 @@ func (s *TradeService) CancelBatchOrders(symbol string, orderIDs []string, clien
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("DELETE", "/openApi/swap/v2/trade/batchOrders", params)
+	return s.client.RequestContext(ctx, "DELETE", "/openApi/swap/v2/trade/batchOrders", params)
 }
 
 func (s *TradeService) GetOrder(symbol, orderID string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/trade/order", map[string]interface{}{
+	return s.GetOrderContext(context.Background(), symbol, orderID)
+}
+
+func (s *TradeService) GetOrderContext(ctx context.Context, symbol, orderID string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/order", map[string]interface{}{
 		"symbol":  symbol,
 		"orderId": orderID,
 	})
 }
 
 func (s *TradeService) GetOpenOrders(symbol *string, limit int) (map[string]interface{}, error) {
+	return s.GetOpenOrdersContext(context.Background(), symbol, limit)
+}
+
+func (s *TradeService) GetOpenOrdersContext(ctx context.Context, symbol *string, limit int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"limit": limit,
 	}
@@ -328,10 +376,14 @@ func (s *TradeService) GetOpenOrders(symbol *string, limit int) (map[string]inte
 		params["symbol"] = *symbol
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/openOrders", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/openOrders", params)
 }
 
 func (s *TradeService) GetOrderHistory(symbol *string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetOrderHistoryContext(context.Background(), symbol, limit, startTime, endTime)
+}
+
+func (s *TradeService) GetOrderHistoryContext(ctx context.Context, symbol *string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"limit": limit,
 	}
@@ -346,10 +398,14 @@ func (s *TradeService) GetOrderHistory(symbol *string, limit int, startTime, end
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/orderHistory", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/orderHistory", params)
 }
 
 func (s *TradeService) GetFilledOrders(symbol *string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetFilledOrdersContext(context.Background(), symbol, limit, startTime, endTime)
+}
+
+func (s *TradeService) GetFilledOrdersContext(ctx context.Context, symbol *string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"limit": limit,
 	}
@@ -364,10 +420,14 @@ func (s *TradeService) GetFilledOrders(symbol *string, limit int, startTime, end
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/filledOrders", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/filledOrders", params)
 }
 
 func (s *TradeService) GetUserTrades(symbol *string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetUserTradesContext(context.Background(), symbol, limit, startTime, endTime)
+}
+
+func (s *TradeService) GetUserTradesContext(ctx context.Context, symbol *string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"limit": limit,
 	}
@@ -382,143 +442,165 @@ func (s *TradeService) GetUserTrades(symbol *string, limit int, startTime, endTi
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/userTrades", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/userTrades", params)
 }
 
 func (s *TradeService) ChangeLeverage(symbol, side string, leverage int, recvWindow *int) (map[string]interface{}, error) {
+	return s.ChangeLeverageContext(context.Background(), symbol, side, leverage, recvWindow)
+}
+
+func (s *TradeService) ChangeLeverageContext(ctx context.Context, symbol, side string, leverage int, recvWindow *int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
-		"symbol":    symbol,
-		"side":      side,
-		"leverage":  leverage,
-		"timestamp": time.Now().UnixMilli(),
+		"symbol":   symbol,
+		"side":     side,
+		"leverage": leverage,
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("POST", "/openApi/swap/v2/trade/leverage", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/leverage", params)
 }
 
 func (s *TradeService) OneClickReversePosition(symbol string, recvWindow *int64) (map[string]interface{}, error) {
+	return s.OneClickReversePositionContext(context.Background(), symbol, recvWindow)
+}
+
+func (s *TradeService) OneClickReversePositionContext(ctx context.Context, symbol string, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
-		"symbol":    symbol,
-		"timestamp": time.Now().UnixMilli(),
+		"symbol": symbol,
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("POST", "/openApi/swap/v2/trade/oneClickReversePosition", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/oneClickReversePosition", params)
 }
 
 func (s *TradeService) SetAutoAddMargin(symbol, positionSide string, autoAddMargin bool, recvWindow *int64) (map[string]interface{}, error) {
+	return s.SetAutoAddMarginContext(context.Background(), symbol, positionSide, autoAddMargin, recvWindow)
+}
+
+func (s *TradeService) SetAutoAddMarginContext(ctx context.Context, symbol, positionSide string, autoAddMargin bool, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol":        symbol,
 		"positionSide":  positionSide,
 		"autoAddMargin": autoAddMargin,
-		"timestamp":     time.Now().UnixMilli(),
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("POST", "/openApi/swap/v2/trade/autoAddMargin", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/autoAddMargin", params)
 }
 
 func (s *TradeService) SwitchMultiAssetsMode(multiAssetsMargin bool, recvWindow *int64) (map[string]interface{}, error) {
+	return s.SwitchMultiAssetsModeContext(context.Background(), multiAssetsMargin, recvWindow)
+}
+
+func (s *TradeService) SwitchMultiAssetsModeContext(ctx context.Context, multiAssetsMargin bool, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"multiAssetsMargin": multiAssetsMargin,
-		"timestamp":         time.Now().UnixMilli(),
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("POST", "/openApi/swap/v2/trade/multiAssetsMode", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/multiAssetsMode", params)
 }
 
 func (s *TradeService) GetMultiAssetsMode(recvWindow *int64) (map[string]interface{}, error) {
-	params := map[string]interface{}{
-		"timestamp": time.Now().UnixMilli(),
-	}
+	return s.GetMultiAssetsModeContext(context.Background(), recvWindow)
+}
+
+func (s *TradeService) GetMultiAssetsModeContext(ctx context.Context, recvWindow *int64) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/multiAssetsMode", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/multiAssetsMode", params)
 }
 
 func (s *TradeService) GetMultiAssetsRules(recvWindow *int64) (map[string]interface{}, error) {
-	params := map[string]interface{}{
-		"timestamp": time.Now().UnixMilli(),
-	}
+	return s.GetMultiAssetsRulesContext(context.Background(), recvWindow)
+}
+
+func (s *TradeService) GetMultiAssetsRulesContext(ctx context.Context, recvWindow *int64) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/multiAssetsRules", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/multiAssetsRules", params)
 }
 
 func (s *TradeService) GetMultiAssetsMargin(recvWindow *int64) (map[string]interface{}, error) {
-	params := map[string]interface{}{
-		"timestamp": time.Now().UnixMilli(),
-	}
+	return s.GetMultiAssetsMarginContext(context.Background(), recvWindow)
+}
+
+func (s *TradeService) GetMultiAssetsMarginContext(ctx context.Context, recvWindow *int64) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/multiAssetsMargin", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/multiAssetsMargin", params)
 }
 
 func (s *TradeService) PlaceTWAPOrder(params map[string]interface{}) (map[string]interface{}, error) {
-	if params == nil {
-		params = make(map[string]interface{})
-	}
-
-	if _, exists := params["timestamp"]; !exists {
-		params["timestamp"] = time.Now().UnixMilli()
-	}
+	return s.PlaceTWAPOrderContext(context.Background(), params)
+}
 
-	return s.client.Request("POST", "/openApi/swap/v2/trade/twapOrder", params)
+func (s *TradeService) PlaceTWAPOrderContext(ctx context.Context, params map[string]interface{}) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/twapOrder", params)
 }
 
 func (s *TradeService) CancelTWAPOrder(orderId string, recvWindow *int64) (map[string]interface{}, error) {
+	return s.CancelTWAPOrderContext(context.Background(), orderId, recvWindow)
+}
+
+func (s *TradeService) CancelTWAPOrderContext(ctx context.Context, orderId string, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
-		"orderId":   orderId,
-		"timestamp": time.Now().UnixMilli(),
+		"orderId": orderId,
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("DELETE", "/openApi/swap/v2/trade/twapOrder", params)
+	return s.client.RequestContext(ctx, "DELETE", "/openApi/swap/v2/trade/twapOrder", params)
 }
 
 func (s *TradeService) GetTWAPOrder(orderId string, recvWindow *int64) (map[string]interface{}, error) {
+	return s.GetTWAPOrderContext(context.Background(), orderId, recvWindow)
+}
+
+func (s *TradeService) GetTWAPOrderContext(ctx context.Context, orderId string, recvWindow *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
-		"orderId":   orderId,
-		"timestamp": time.Now().UnixMilli(),
+		"orderId": orderId,
 	}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/twapOrder", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/twapOrder", params)
 }
 
 func (s *TradeService) GetTWAPOrders(symbol *string, status *string, startTime, endTime *int64, limit int, recvWindow *int64) (map[string]interface{}, error) {
-	params := map[string]interface{}{
-		"timestamp": time.Now().UnixMilli(),
-	}
+	return s.GetTWAPOrdersContext(context.Background(), symbol, status, startTime, endTime, limit, recvWindow)
+}
+
+func (s *TradeService) GetTWAPOrdersContext(ctx context.Context, symbol *string, status *string, startTime, endTime *int64, limit int, recvWindow *int64) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
 
 	if symbol != nil {
 		params["symbol"] = *symbol
@@ -539,19 +621,21 @@ func (s *TradeService) GetTWAPOrders(symbol *string, status *string, startTime,
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/twapOrders", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/twapOrders", params)
 }
 
 // GetVst retrieves VST (Virtual Simulation Trading) information
 // This enables demo trading functionality in the simulated environment
 func (s *TradeService) GetVst(recvWindow *int64) (map[string]interface{}, error) {
-	params := map[string]interface{}{
-		"timestamp": time.Now().UnixMilli(),
-	}
+	return s.GetVstContext(context.Background(), recvWindow)
+}
+
+func (s *TradeService) GetVstContext(ctx context.Context, recvWindow *int64) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
 
 	if recvWindow != nil {
 		params["recvWindow"] = *recvWindow
 	}
 
-	return s.client.Request("POST", "/openApi/swap/v2/trade/getVst", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/getVst", params)
 }
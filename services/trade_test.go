@@ -1,7 +1,11 @@
 package services
 
 import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/tigusigalpa/bingx-go/v2/http"
 )
@@ -55,6 +59,14 @@ func TestCalculateFuturesCommission(t *testing.T) {
 			expectedPV:     6250.0,
 			expectedComm:   2.8125,
 		},
+		{
+			name:           "Decimal inputs are not skewed by float64",
+			margin:         0.1,
+			leverage:       3,
+			commissionRate: func() *float64 { v := 0.1; return &v }(),
+			expectedPV:     0.3,
+			expectedComm:   0.03,
+		},
 	}
 
 	for _, tt := range tests {
@@ -84,6 +96,22 @@ func TestCalculateFuturesCommission(t *testing.T) {
 	}
 }
 
+func TestCalculateFuturesCommission_RoundedTruncatesExactValue(t *testing.T) {
+	client := http.NewBaseHTTPClient("key", "secret", "https://api.test.com", "", "base64")
+	service := NewTradeService(client)
+
+	rate := 0.001
+	result := service.CalculateFuturesCommission(8.2, 1, &rate)
+	if result.CommissionRounded != 0.0082 {
+		t.Errorf("Expected rounded commission 0.0082, got %v", result.CommissionRounded)
+	}
+
+	result = service.CalculateFuturesCommission(1e9, 125, nil)
+	if result.CommissionRounded != 56250000 {
+		t.Errorf("Expected rounded commission 56250000, got %v", result.CommissionRounded)
+	}
+}
+
 func TestGetCommissionAmount(t *testing.T) {
 	client := http.NewBaseHTTPClient("key", "secret", "https://api.test.com", "", "base64")
 	service := NewTradeService(client)
@@ -395,3 +423,34 @@ func TestGetOrderHistory(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrderContext_DeadlineExceeded(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+		_, _ = w.Write([]byte(`{"code":0,"data":{}}`))
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	service := NewTradeService(http.NewBaseHTTPClient("key", "secret", srv.URL, "", "hex"))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := service.CreateOrderContext(ctx, map[string]interface{}{
+		"symbol":   "BTC-USDT",
+		"side":     "BUY",
+		"type":     OrderTypeMarket,
+		"quantity": 0.001,
+	})
+	if err == nil {
+		t.Fatal("expected CreateOrderContext to fail once the deadline passes")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("CreateOrderContext was not aborted by the deadline, took %s", elapsed)
+	}
+}
@@ -1,7 +1,7 @@
 package tradfi
 
 import (
-	"time"
+	"context"
 
 	"github.com/tigusigalpa/bingx-go/v2/http"
 )
@@ -16,39 +16,57 @@ func NewAccountService(client *http.BaseHTTPClient) *AccountService {
 
 // GetBalance retrieves TradFi account balance
 func (s *AccountService) GetBalance() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v3/user/balance", nil)
+	return s.GetBalanceContext(context.Background())
+}
+
+func (s *AccountService) GetBalanceContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v3/user/balance", nil)
 }
 
 // GetAccountInfo retrieves comprehensive account information
 func (s *AccountService) GetAccountInfo() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/account", nil)
+	return s.GetAccountInfoContext(context.Background())
+}
+
+func (s *AccountService) GetAccountInfoContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/account", nil)
 }
 
 // GetPositions retrieves open positions for TradFi instruments
 func (s *AccountService) GetPositions(symbol *string) (map[string]interface{}, error) {
+	return s.GetPositionsContext(context.Background(), symbol)
+}
+
+func (s *AccountService) GetPositionsContext(ctx context.Context, symbol *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 	if symbol != nil {
 		params["symbol"] = *symbol
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/user/positions", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/positions", params)
 }
 
 // GetPositionRisk retrieves position risk data including liquidation price
 func (s *AccountService) GetPositionRisk(symbol *string) (map[string]interface{}, error) {
+	return s.GetPositionRiskContext(context.Background(), symbol)
+}
+
+func (s *AccountService) GetPositionRiskContext(ctx context.Context, symbol *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 	if symbol != nil {
 		params["symbol"] = *symbol
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/user/positionRisk", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/positionRisk", params)
 }
 
 // GetIncomeHistory retrieves income history (PNL, funding fees, commissions)
 func (s *AccountService) GetIncomeHistory(symbol *string, incomeType *string, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
-	params := map[string]interface{}{
-		"timestamp": time.Now().UnixMilli(),
-	}
+	return s.GetIncomeHistoryContext(context.Background(), symbol, incomeType, startTime, endTime, limit)
+}
+
+func (s *AccountService) GetIncomeHistoryContext(ctx context.Context, symbol *string, incomeType *string, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
 	if symbol != nil {
 		params["symbol"] = *symbol
 	}
@@ -65,11 +83,15 @@ func (s *AccountService) GetIncomeHistory(symbol *string, incomeType *string, st
 		params["limit"] = limit
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/user/income", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/income", params)
 }
 
 // GetCommissionHistory retrieves commission history for TradFi trades
 func (s *AccountService) GetCommissionHistory(symbol string, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
+	return s.GetCommissionHistoryContext(context.Background(), symbol, startTime, endTime, limit)
+}
+
+func (s *AccountService) GetCommissionHistoryContext(ctx context.Context, symbol string, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
@@ -81,11 +103,15 @@ func (s *AccountService) GetCommissionHistory(symbol string, startTime, endTime
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/user/commissionRate", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/commissionRate", params)
 }
 
 // GetForceOrders retrieves liquidation/force order history
 func (s *AccountService) GetForceOrders(symbol *string, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
+	return s.GetForceOrdersContext(context.Background(), symbol, startTime, endTime, limit)
+}
+
+func (s *AccountService) GetForceOrdersContext(ctx context.Context, symbol *string, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"limit": limit,
 	}
@@ -99,31 +125,47 @@ func (s *AccountService) GetForceOrders(symbol *string, startTime, endTime *int6
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/user/forceOrders", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/forceOrders", params)
 }
 
 // GetPositionMode retrieves current position mode (hedge or one-way)
 func (s *AccountService) GetPositionMode() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/positionMode", nil)
+	return s.GetPositionModeContext(context.Background())
+}
+
+func (s *AccountService) GetPositionModeContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/positionMode", nil)
 }
 
 // SetPositionMode sets position mode (true = hedge mode, false = one-way mode)
 func (s *AccountService) SetPositionMode(hedgeMode bool) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/user/positionMode", map[string]interface{}{
+	return s.SetPositionModeContext(context.Background(), hedgeMode)
+}
+
+func (s *AccountService) SetPositionModeContext(ctx context.Context, hedgeMode bool) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/user/positionMode", map[string]interface{}{
 		"positionMode": map[bool]string{true: "HEDGE", false: "ONEWAY"}[hedgeMode],
 	})
 }
 
 // GetMarginMode retrieves margin mode for a symbol
 func (s *AccountService) GetMarginMode(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/getMarginMode", map[string]interface{}{
+	return s.GetMarginModeContext(context.Background(), symbol)
+}
+
+func (s *AccountService) GetMarginModeContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/getMarginMode", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 // SetMarginMode sets margin mode (ISOLATED or CROSSED)
 func (s *AccountService) SetMarginMode(symbol, marginMode string) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/user/setMarginMode", map[string]interface{}{
+	return s.SetMarginModeContext(context.Background(), symbol, marginMode)
+}
+
+func (s *AccountService) SetMarginModeContext(ctx context.Context, symbol, marginMode string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/user/setMarginMode", map[string]interface{}{
 		"symbol":     symbol,
 		"marginMode": marginMode,
 	})
@@ -131,43 +173,71 @@ func (s *AccountService) SetMarginMode(symbol, marginMode string) (map[string]in
 
 // GetTradingFees retrieves trading fee rates for TradFi instruments
 func (s *AccountService) GetTradingFees(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/tradingFees", map[string]interface{}{
+	return s.GetTradingFeesContext(context.Background(), symbol)
+}
+
+func (s *AccountService) GetTradingFeesContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/tradingFees", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 // GetUserCommissionRates retrieves user commission rates
 func (s *AccountService) GetUserCommissionRates(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/commissionRate", map[string]interface{}{
+	return s.GetUserCommissionRatesContext(context.Background(), symbol)
+}
+
+func (s *AccountService) GetUserCommissionRatesContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/commissionRate", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 // GetMultiAssetsMode retrieves multi-assets margin mode status
 func (s *AccountService) GetMultiAssetsMode() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/multiAssetsMode", nil)
+	return s.GetMultiAssetsModeContext(context.Background())
+}
+
+func (s *AccountService) GetMultiAssetsModeContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/multiAssetsMode", nil)
 }
 
 // SetMultiAssetsMode enables/disables multi-assets margin mode
 func (s *AccountService) SetMultiAssetsMode(enabled bool) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/user/multiAssetsMode", map[string]interface{}{
+	return s.SetMultiAssetsModeContext(context.Background(), enabled)
+}
+
+func (s *AccountService) SetMultiAssetsModeContext(ctx context.Context, enabled bool) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/user/multiAssetsMode", map[string]interface{}{
 		"multiAssetsMode": enabled,
 	})
 }
 
 // GetMultiAssetsMargin retrieves multi-assets margin details
 func (s *AccountService) GetMultiAssetsMargin() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/multiAssetsMargin", nil)
+	return s.GetMultiAssetsMarginContext(context.Background())
+}
+
+func (s *AccountService) GetMultiAssetsMarginContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/multiAssetsMargin", nil)
 }
 
 // GetAPIPermissions retrieves API key permissions
 func (s *AccountService) GetAPIPermissions() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/apiPermissions", nil)
+	return s.GetAPIPermissionsContext(context.Background())
+}
+
+func (s *AccountService) GetAPIPermissionsContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/apiPermissions", nil)
 }
 
 // GetBalanceHistory retrieves balance history
 func (s *AccountService) GetBalanceHistory(coin string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/user/balanceHistory", map[string]interface{}{
+	return s.GetBalanceHistoryContext(context.Background(), coin, limit)
+}
+
+func (s *AccountService) GetBalanceHistoryContext(ctx context.Context, coin string, limit int) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/user/balanceHistory", map[string]interface{}{
 		"coin":  coin,
 		"limit": limit,
 	})
@@ -1,6 +1,10 @@
 package tradfi
 
-import "github.com/tigusigalpa/bingx-go/v2/http"
+import (
+	"context"
+
+	"github.com/tigusigalpa/bingx-go/v2/http"
+)
 
 type ListenKeyService struct {
 	client *http.BaseHTTPClient
@@ -12,19 +16,31 @@ func NewListenKeyService(client *http.BaseHTTPClient) *ListenKeyService {
 
 // Create generates a new listen key for TradFi WebSocket streams
 func (s *ListenKeyService) Create() (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/user/listenKey", nil)
+	return s.CreateContext(context.Background())
+}
+
+func (s *ListenKeyService) CreateContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/user/listenKey", nil)
 }
 
 // Extend extends the validity of an existing listen key
 func (s *ListenKeyService) Extend(listenKey string) (map[string]interface{}, error) {
-	return s.client.Request("PUT", "/openApi/swap/v2/user/listenKey", map[string]interface{}{
+	return s.ExtendContext(context.Background(), listenKey)
+}
+
+func (s *ListenKeyService) ExtendContext(ctx context.Context, listenKey string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "PUT", "/openApi/swap/v2/user/listenKey", map[string]interface{}{
 		"listenKey": listenKey,
 	})
 }
 
 // Delete deletes a listen key
 func (s *ListenKeyService) Delete(listenKey string) (map[string]interface{}, error) {
-	return s.client.Request("DELETE", "/openApi/swap/v2/user/listenKey", map[string]interface{}{
+	return s.DeleteContext(context.Background(), listenKey)
+}
+
+func (s *ListenKeyService) DeleteContext(ctx context.Context, listenKey string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "DELETE", "/openApi/swap/v2/user/listenKey", map[string]interface{}{
 		"listenKey": listenKey,
 	})
 }
@@ -1,6 +1,10 @@
 package tradfi
 
-import "github.com/tigusigalpa/bingx-go/v2/http"
+import (
+	"context"
+
+	"github.com/tigusigalpa/bingx-go/v2/http"
+)
 
 type MarketService struct {
 	client *http.BaseHTTPClient
@@ -12,54 +16,86 @@ func NewMarketService(client *http.BaseHTTPClient) *MarketService {
 
 // GetSymbols retrieves all TradFi trading symbols (stocks, forex, commodities, indices)
 func (s *MarketService) GetSymbols() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/contracts", nil)
+	return s.GetSymbolsContext(context.Background())
+}
+
+func (s *MarketService) GetSymbolsContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/contracts", nil)
 }
 
 // GetStockSymbols retrieves stock token symbols (TSLA, AAPL, etc.)
 func (s *MarketService) GetStockSymbols() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/contracts", map[string]interface{}{
+	return s.GetStockSymbolsContext(context.Background())
+}
+
+func (s *MarketService) GetStockSymbolsContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/contracts", map[string]interface{}{
 		"assetType": "STOCK",
 	})
 }
 
 // GetForexSymbols retrieves forex trading symbols (EUR-USD, GBP-USD, etc.)
 func (s *MarketService) GetForexSymbols() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/contracts", map[string]interface{}{
+	return s.GetForexSymbolsContext(context.Background())
+}
+
+func (s *MarketService) GetForexSymbolsContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/contracts", map[string]interface{}{
 		"assetType": "FOREX",
 	})
 }
 
 // GetCommoditySymbols retrieves commodity symbols (GOLD, SILVER, OIL, etc.)
 func (s *MarketService) GetCommoditySymbols() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/contracts", map[string]interface{}{
+	return s.GetCommoditySymbolsContext(context.Background())
+}
+
+func (s *MarketService) GetCommoditySymbolsContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/contracts", map[string]interface{}{
 		"assetType": "COMMODITY",
 	})
 }
 
 // GetIndexSymbols retrieves stock index symbols (SPX, DJI, etc.)
 func (s *MarketService) GetIndexSymbols() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/contracts", map[string]interface{}{
+	return s.GetIndexSymbolsContext(context.Background())
+}
+
+func (s *MarketService) GetIndexSymbolsContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/contracts", map[string]interface{}{
 		"assetType": "INDEX",
 	})
 }
 
 // GetTicker retrieves 24h ticker data for a TradFi symbol
 func (s *MarketService) GetTicker(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/ticker", map[string]interface{}{
+	return s.GetTickerContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetTickerContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/ticker", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 // GetLatestPrice retrieves latest price for a TradFi symbol
 func (s *MarketService) GetLatestPrice(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/market/latestPrice", map[string]interface{}{
+	return s.GetLatestPriceContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetLatestPriceContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/latestPrice", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 // GetDepth retrieves order book depth for a TradFi symbol
 func (s *MarketService) GetDepth(symbol string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/depth", map[string]interface{}{
+	return s.GetDepthContext(context.Background(), symbol, limit)
+}
+
+func (s *MarketService) GetDepthContext(ctx context.Context, symbol string, limit int) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/depth", map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
 	})
@@ -67,6 +103,10 @@ func (s *MarketService) GetDepth(symbol string, limit int) (map[string]interface
 
 // GetKlines retrieves kline/candlestick data for a TradFi symbol
 func (s *MarketService) GetKlines(symbol, interval string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetKlinesContext(context.Background(), symbol, interval, limit, startTime, endTime)
+}
+
+func (s *MarketService) GetKlinesContext(ctx context.Context, symbol, interval string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol":   symbol,
 		"interval": interval,
@@ -80,26 +120,38 @@ func (s *MarketService) GetKlines(symbol, interval string, limit int, startTime,
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v3/quote/klines", params)
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v3/quote/klines", params)
 }
 
 // GetMarkPrice retrieves mark price for a TradFi symbol
 func (s *MarketService) GetMarkPrice(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/premiumIndex", map[string]interface{}{
+	return s.GetMarkPriceContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetMarkPriceContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/premiumIndex", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 // GetFundingRate retrieves current funding rate for a TradFi perpetual
 func (s *MarketService) GetFundingRate(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/fundingRate", map[string]interface{}{
+	return s.GetFundingRateContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetFundingRateContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/fundingRate", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 // GetFundingRateHistory retrieves historical funding rates
 func (s *MarketService) GetFundingRateHistory(symbol string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/market/fundingRate/history", map[string]interface{}{
+	return s.GetFundingRateHistoryContext(context.Background(), symbol, limit)
+}
+
+func (s *MarketService) GetFundingRateHistoryContext(ctx context.Context, symbol string, limit int) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/market/fundingRate/history", map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
 	})
@@ -107,14 +159,22 @@ func (s *MarketService) GetFundingRateHistory(symbol string, limit int) (map[str
 
 // GetOpenInterest retrieves open interest for a TradFi symbol
 func (s *MarketService) GetOpenInterest(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/openInterest", map[string]interface{}{
+	return s.GetOpenInterestContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetOpenInterestContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/openInterest", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 // GetRecentTrades retrieves recent public trades
 func (s *MarketService) GetRecentTrades(symbol string, limit int) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/trades", map[string]interface{}{
+	return s.GetRecentTradesContext(context.Background(), symbol, limit)
+}
+
+func (s *MarketService) GetRecentTradesContext(ctx context.Context, symbol string, limit int) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/trades", map[string]interface{}{
 		"symbol": symbol,
 		"limit":  limit,
 	})
@@ -122,14 +182,22 @@ func (s *MarketService) GetRecentTrades(symbol string, limit int) (map[string]in
 
 // GetBookTicker retrieves best bid/ask price and quantity
 func (s *MarketService) GetBookTicker(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/quote/bookTicker", map[string]interface{}{
+	return s.GetBookTickerContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetBookTickerContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v2/quote/bookTicker", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 // GetTradingRules retrieves trading rules and specifications for TradFi symbols
 func (s *MarketService) GetTradingRules(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v1/tradingRules", map[string]interface{}{
+	return s.GetTradingRulesContext(context.Background(), symbol)
+}
+
+func (s *MarketService) GetTradingRulesContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.PublicRequestContext(ctx, "GET", "/openApi/swap/v1/tradingRules", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
@@ -1,7 +1,7 @@
 package tradfi
 
 import (
-	"time"
+	"context"
 
 	"github.com/tigusigalpa/bingx-go/v2/http"
 )
@@ -28,16 +28,28 @@ const (
 
 // CreateOrder places a new order for TradFi instruments
 func (s *TradeService) CreateOrder(params map[string]interface{}) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/trade/order", params)
+	return s.CreateOrderContext(context.Background(), params)
+}
+
+func (s *TradeService) CreateOrderContext(ctx context.Context, params map[string]interface{}) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/order", params)
 }
 
 // CreateTestOrder validates an order without executing it
 func (s *TradeService) CreateTestOrder(params map[string]interface{}) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/trade/order/test", params)
+	return s.CreateTestOrderContext(context.Background(), params)
+}
+
+func (s *TradeService) CreateTestOrderContext(ctx context.Context, params map[string]interface{}) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/order/test", params)
 }
 
 // CancelOrder cancels an order by ID or client order ID
 func (s *TradeService) CancelOrder(symbol string, orderID *string, clientOrderID *string) (map[string]interface{}, error) {
+	return s.CancelOrderContext(context.Background(), symbol, orderID, clientOrderID)
+}
+
+func (s *TradeService) CancelOrderContext(ctx context.Context, symbol string, orderID *string, clientOrderID *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol": symbol,
 	}
@@ -49,22 +61,30 @@ func (s *TradeService) CancelOrder(symbol string, orderID *string, clientOrderID
 		params["clientOrderId"] = *clientOrderID
 	}
 
-	return s.client.Request("DELETE", "/openApi/swap/v2/trade/order", params)
+	return s.client.RequestContext(ctx, "DELETE", "/openApi/swap/v2/trade/order", params)
 }
 
 // CancelAllOrders cancels all open orders for a symbol
 func (s *TradeService) CancelAllOrders(symbol *string) (map[string]interface{}, error) {
+	return s.CancelAllOrdersContext(context.Background(), symbol)
+}
+
+func (s *TradeService) CancelAllOrdersContext(ctx context.Context, symbol *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{}
 	if symbol != nil {
 		params["symbol"] = *symbol
 	}
 
-	return s.client.Request("DELETE", "/openApi/swap/v2/trade/allOpenOrders", params)
+	return s.client.RequestContext(ctx, "DELETE", "/openApi/swap/v2/trade/allOpenOrders", params)
 }
 
 // GetOrder retrieves order details
 func (s *TradeService) GetOrder(symbol, orderID string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/trade/orderDetail", map[string]interface{}{
+	return s.GetOrderContext(context.Background(), symbol, orderID)
+}
+
+func (s *TradeService) GetOrderContext(ctx context.Context, symbol, orderID string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/orderDetail", map[string]interface{}{
 		"symbol":  symbol,
 		"orderId": orderID,
 	})
@@ -72,6 +92,10 @@ func (s *TradeService) GetOrder(symbol, orderID string) (map[string]interface{},
 
 // GetOpenOrders retrieves all open orders
 func (s *TradeService) GetOpenOrders(symbol *string, limit int) (map[string]interface{}, error) {
+	return s.GetOpenOrdersContext(context.Background(), symbol, limit)
+}
+
+func (s *TradeService) GetOpenOrdersContext(ctx context.Context, symbol *string, limit int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"limit": limit,
 	}
@@ -79,11 +103,15 @@ func (s *TradeService) GetOpenOrders(symbol *string, limit int) (map[string]inte
 		params["symbol"] = *symbol
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/openOrders", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/openOrders", params)
 }
 
 // GetOrderHistory retrieves historical orders
 func (s *TradeService) GetOrderHistory(symbol *string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetOrderHistoryContext(context.Background(), symbol, limit, startTime, endTime)
+}
+
+func (s *TradeService) GetOrderHistoryContext(ctx context.Context, symbol *string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"limit": limit,
 	}
@@ -97,11 +125,15 @@ func (s *TradeService) GetOrderHistory(symbol *string, limit int, startTime, end
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/orderHistory", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/orderHistory", params)
 }
 
 // GetUserTrades retrieves user trade history
 func (s *TradeService) GetUserTrades(symbol *string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
+	return s.GetUserTradesContext(context.Background(), symbol, limit, startTime, endTime)
+}
+
+func (s *TradeService) GetUserTradesContext(ctx context.Context, symbol *string, limit int, startTime, endTime *int64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"limit": limit,
 	}
@@ -115,49 +147,67 @@ func (s *TradeService) GetUserTrades(symbol *string, limit int, startTime, endTi
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/fillHistory", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/fillHistory", params)
 }
 
 // SetLeverage sets leverage for a TradFi symbol
 func (s *TradeService) SetLeverage(symbol string, leverage int, side *string) (map[string]interface{}, error) {
+	return s.SetLeverageContext(context.Background(), symbol, leverage, side)
+}
+
+func (s *TradeService) SetLeverageContext(ctx context.Context, symbol string, leverage int, side *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{
-		"symbol":    symbol,
-		"leverage":  leverage,
-		"timestamp": time.Now().UnixMilli(),
+		"symbol":   symbol,
+		"leverage": leverage,
 	}
 	if side != nil {
 		params["side"] = *side
 	}
 
-	return s.client.Request("POST", "/openApi/swap/v2/trade/leverage", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/leverage", params)
 }
 
 // GetLeverage retrieves current leverage for a TradFi symbol
 func (s *TradeService) GetLeverage(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/trade/leverage", map[string]interface{}{
+	return s.GetLeverageContext(context.Background(), symbol)
+}
+
+func (s *TradeService) GetLeverageContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/leverage", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 // SetMarginType sets margin type (ISOLATED or CROSSED)
 func (s *TradeService) SetMarginType(symbol, marginType string) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/trade/marginType", map[string]interface{}{
+	return s.SetMarginTypeContext(context.Background(), symbol, marginType)
+}
+
+func (s *TradeService) SetMarginTypeContext(ctx context.Context, symbol, marginType string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/marginType", map[string]interface{}{
 		"symbol":     symbol,
 		"marginType": marginType,
-		"timestamp":  time.Now().UnixMilli(),
 	})
 }
 
 // GetMarginType retrieves current margin type
 func (s *TradeService) GetMarginType(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/trade/marginType", map[string]interface{}{
+	return s.GetMarginTypeContext(context.Background(), symbol)
+}
+
+func (s *TradeService) GetMarginTypeContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/marginType", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 // SetPositionMargin adds or reduces position margin
 func (s *TradeService) SetPositionMargin(symbol, positionSide string, amount float64, marginType int) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/trade/positionMargin", map[string]interface{}{
+	return s.SetPositionMarginContext(context.Background(), symbol, positionSide, amount, marginType)
+}
+
+func (s *TradeService) SetPositionMarginContext(ctx context.Context, symbol, positionSide string, amount float64, marginType int) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/positionMargin", map[string]interface{}{
 		"symbol":       symbol,
 		"positionSide": positionSide,
 		"amount":       amount,
@@ -167,25 +217,41 @@ func (s *TradeService) SetPositionMargin(symbol, positionSide string, amount flo
 
 // OneClickReversePosition reverses position side (LONG <-> SHORT)
 func (s *TradeService) OneClickReversePosition(symbol string) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/trade/oneClickReversePosition", map[string]interface{}{
+	return s.OneClickReversePositionContext(context.Background(), symbol)
+}
+
+func (s *TradeService) OneClickReversePositionContext(ctx context.Context, symbol string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/oneClickReversePosition", map[string]interface{}{
 		"symbol": symbol,
 	})
 }
 
 // PlaceTWAPOrder places a TWAP (Time-Weighted Average Price) order
 func (s *TradeService) PlaceTWAPOrder(params map[string]interface{}) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/trade/twap/order", params)
+	return s.PlaceTWAPOrderContext(context.Background(), params)
+}
+
+func (s *TradeService) PlaceTWAPOrderContext(ctx context.Context, params map[string]interface{}) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/twap/order", params)
 }
 
 // GetTWAPOrder retrieves TWAP order details
 func (s *TradeService) GetTWAPOrder(orderID string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/swap/v2/trade/twap/order", map[string]interface{}{
+	return s.GetTWAPOrderContext(context.Background(), orderID)
+}
+
+func (s *TradeService) GetTWAPOrderContext(ctx context.Context, orderID string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/twap/order", map[string]interface{}{
 		"orderId": orderID,
 	})
 }
 
 // GetTWAPOrders retrieves all TWAP orders
 func (s *TradeService) GetTWAPOrders(symbol *string, status *string, limit int) (map[string]interface{}, error) {
+	return s.GetTWAPOrdersContext(context.Background(), symbol, status, limit)
+}
+
+func (s *TradeService) GetTWAPOrdersContext(ctx context.Context, symbol *string, status *string, limit int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"limit": limit,
 	}
@@ -196,18 +262,26 @@ func (s *TradeService) GetTWAPOrders(symbol *string, status *string, limit int)
 		params["status"] = *status
 	}
 
-	return s.client.Request("GET", "/openApi/swap/v2/trade/twap/orders", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/swap/v2/trade/twap/orders", params)
 }
 
 // CancelTWAPOrder cancels a TWAP order
 func (s *TradeService) CancelTWAPOrder(orderID string) (map[string]interface{}, error) {
-	return s.client.Request("DELETE", "/openApi/swap/v2/trade/twap/order", map[string]interface{}{
+	return s.CancelTWAPOrderContext(context.Background(), orderID)
+}
+
+func (s *TradeService) CancelTWAPOrderContext(ctx context.Context, orderID string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "DELETE", "/openApi/swap/v2/trade/twap/order", map[string]interface{}{
 		"orderId": orderID,
 	})
 }
 
 // ModifyOrder modifies an existing order's price and/or quantity
 func (s *TradeService) ModifyOrder(symbol string, orderID *string, clientOrderID *string, price, quantity float64) (map[string]interface{}, error) {
+	return s.ModifyOrderContext(context.Background(), symbol, orderID, clientOrderID, price, quantity)
+}
+
+func (s *TradeService) ModifyOrderContext(ctx context.Context, symbol string, orderID *string, clientOrderID *string, price, quantity float64) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"symbol":   symbol,
 		"price":    price,
@@ -220,12 +294,16 @@ func (s *TradeService) ModifyOrder(symbol string, orderID *string, clientOrderID
 		params["clientOrderId"] = *clientOrderID
 	}
 
-	return s.client.Request("POST", "/openApi/swap/v2/trade/order", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/order", params)
 }
 
 // SetAutoAddMargin enables/disables auto margin addition
 func (s *TradeService) SetAutoAddMargin(symbol, positionSide string, enabled bool) (map[string]interface{}, error) {
-	return s.client.Request("POST", "/openApi/swap/v2/trade/autoAddMargin", map[string]interface{}{
+	return s.SetAutoAddMarginContext(context.Background(), symbol, positionSide, enabled)
+}
+
+func (s *TradeService) SetAutoAddMarginContext(ctx context.Context, symbol, positionSide string, enabled bool) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "POST", "/openApi/swap/v2/trade/autoAddMargin", map[string]interface{}{
 		"symbol":       symbol,
 		"positionSide": positionSide,
 		"enabled":      enabled,
@@ -1,6 +1,10 @@
 package services
 
-import "github.com/tigusigalpa/bingx-go/v2/http"
+import (
+	"context"
+
+	"github.com/tigusigalpa/bingx-go/v2/http"
+)
 
 type WalletService struct {
 	client *http.BaseHTTPClient
@@ -11,6 +15,10 @@ func NewWalletService(client *http.BaseHTTPClient) *WalletService {
 }
 
 func (s *WalletService) GetDepositHistory(coin string, status *int, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
+	return s.GetDepositHistoryContext(context.Background(), coin, status, startTime, endTime, limit)
+}
+
+func (s *WalletService) GetDepositHistoryContext(ctx context.Context, coin string, status *int, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"coin":  coin,
 		"limit": limit,
@@ -26,17 +34,25 @@ func (s *WalletService) GetDepositHistory(coin string, status *int, startTime, e
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/wallets/v1/capital/deposit/history", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/wallets/v1/capital/deposit/history", params)
 }
 
 func (s *WalletService) GetDepositAddress(coin, network string) (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/wallets/v1/capital/deposit/address", map[string]interface{}{
+	return s.GetDepositAddressContext(context.Background(), coin, network)
+}
+
+func (s *WalletService) GetDepositAddressContext(ctx context.Context, coin, network string) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/wallets/v1/capital/deposit/address", map[string]interface{}{
 		"coin":    coin,
 		"network": network,
 	})
 }
 
 func (s *WalletService) GetWithdrawalHistory(coin string, status *int, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
+	return s.GetWithdrawalHistoryContext(context.Background(), coin, status, startTime, endTime, limit)
+}
+
+func (s *WalletService) GetWithdrawalHistoryContext(ctx context.Context, coin string, status *int, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"coin":  coin,
 		"limit": limit,
@@ -52,10 +68,14 @@ func (s *WalletService) GetWithdrawalHistory(coin string, status *int, startTime
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/wallets/v1/capital/withdraw/history", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/wallets/v1/capital/withdraw/history", params)
 }
 
 func (s *WalletService) Withdraw(coin, address string, amount float64, network string, addressTag *string) (map[string]interface{}, error) {
+	return s.WithdrawContext(context.Background(), coin, address, amount, network, addressTag)
+}
+
+func (s *WalletService) WithdrawContext(ctx context.Context, coin, address string, amount float64, network string, addressTag *string) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"coin":    coin,
 		"address": address,
@@ -67,14 +87,22 @@ func (s *WalletService) Withdraw(coin, address string, amount float64, network s
 		params["addressTag"] = *addressTag
 	}
 
-	return s.client.Request("POST", "/openApi/wallets/v1/capital/withdraw/apply", params)
+	return s.client.RequestContext(ctx, "POST", "/openApi/wallets/v1/capital/withdraw/apply", params)
 }
 
 func (s *WalletService) GetAllCoinInfo() (map[string]interface{}, error) {
-	return s.client.Request("GET", "/openApi/wallets/v1/capital/config/getall", nil)
+	return s.GetAllCoinInfoContext(context.Background())
+}
+
+func (s *WalletService) GetAllCoinInfoContext(ctx context.Context) (map[string]interface{}, error) {
+	return s.client.RequestContext(ctx, "GET", "/openApi/wallets/v1/capital/config/getall", nil)
 }
 
 func (s *WalletService) GetMainAccountTransferHistory(coin string, transferType *string, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
+	return s.GetMainAccountTransferHistoryContext(context.Background(), coin, transferType, startTime, endTime, limit)
+}
+
+func (s *WalletService) GetMainAccountTransferHistoryContext(ctx context.Context, coin string, transferType *string, startTime, endTime *int64, limit int) (map[string]interface{}, error) {
 	params := map[string]interface{}{
 		"coin":  coin,
 		"limit": limit,
@@ -90,5 +118,5 @@ func (s *WalletService) GetMainAccountTransferHistory(coin string, transferType
 		params["endTime"] = *endTime
 	}
 
-	return s.client.Request("GET", "/openApi/wallets/v1/capital/transfer/history", params)
+	return s.client.RequestContext(ctx, "GET", "/openApi/wallets/v1/capital/transfer/history", params)
 }
@@ -10,13 +10,26 @@ const AccountDataStreamBaseURL = "wss://open-api-swap.bingx.com/swap-market"
 
 type AccountDataStream struct {
 	*WebSocketClient
+
+	// router dispatches frames to the typed On... handlers. It is created
+	// on first use, guarded by the client's mu.
+	router *eventRouter
+}
+
+// NewAccountDataStream creates a production USDT-M account data stream for
+// listenKey unless WithEnvironment or WithMarket say otherwise. If the
+// environment has no account stream for the market, Connect returns the
+// error of environment.AccountStreamURL.
+func NewAccountDataStream(listenKey string, opts ...StreamOption) *AccountDataStream {
+	cfg := newStreamConfig(opts)
+	baseURL, err := cfg.env.AccountStreamURL(cfg.market)
+	client := NewWebSocketClient(accountStreamURL(baseURL, listenKey))
+	client.urlErr = err
+	return &AccountDataStream{WebSocketClient: client}
 }
 
-func NewAccountDataStream(listenKey string) *AccountDataStream {
-	endpoint := fmt.Sprintf("%s?listenKey=%s", AccountDataStreamBaseURL, url.QueryEscape(listenKey))
-	return &AccountDataStream{
-		WebSocketClient: NewWebSocketClient(endpoint),
-	}
+func accountStreamURL(baseURL, listenKey string) string {
+	return fmt.Sprintf("%s?listenKey=%s", baseURL, url.QueryEscape(listenKey))
 }
 
 type AccountUpdateCallback func(eventType string, data map[string]interface{})
@@ -38,6 +51,10 @@ func (a *AccountDataStream) OnAccountUpdate(callback AccountUpdateCallback) {
 
 type BalanceUpdateCallback func(balances interface{})
 
+// OnBalanceUpdate passes the raw balances of every ACCOUNT_UPDATE event.
+//
+// Deprecated: Use OnAccountUpdateEvent, which decodes the balances into
+// BalanceUpdate values.
 func (a *AccountDataStream) OnBalanceUpdate(callback BalanceUpdateCallback) {
 	a.OnMessage(func(data map[string]interface{}) {
 		if eventType, ok := data["e"].(string); ok && eventType == "ACCOUNT_UPDATE" {
@@ -52,6 +69,10 @@ func (a *AccountDataStream) OnBalanceUpdate(callback BalanceUpdateCallback) {
 
 type PositionUpdateCallback func(positions interface{})
 
+// OnPositionUpdate passes the raw positions of every ACCOUNT_UPDATE event.
+//
+// Deprecated: Use OnAccountUpdateEvent, which decodes the positions into
+// PositionUpdate values.
 func (a *AccountDataStream) OnPositionUpdate(callback PositionUpdateCallback) {
 	a.OnMessage(func(data map[string]interface{}) {
 		if eventType, ok := data["e"].(string); ok && eventType == "ACCOUNT_UPDATE" {
@@ -66,6 +87,10 @@ func (a *AccountDataStream) OnPositionUpdate(callback PositionUpdateCallback) {
 
 type OrderUpdateCallback func(order interface{})
 
+// OnOrderUpdate passes the raw order of every ORDER_TRADE_UPDATE event.
+//
+// Deprecated: Use OnOrderTradeUpdate, which decodes the order into an
+// OrderUpdate.
 func (a *AccountDataStream) OnOrderUpdate(callback OrderUpdateCallback) {
 	a.OnMessage(func(data map[string]interface{}) {
 		if eventType, ok := data["e"].(string); ok && eventType == "ORDER_TRADE_UPDATE" {
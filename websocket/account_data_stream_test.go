@@ -3,6 +3,8 @@ package websocket
 import (
 	"strings"
 	"testing"
+
+	"github.com/tigusigalpa/bingx-go/v2/environment"
 )
 
 func TestNewAccountDataStream(t *testing.T) {
@@ -99,3 +101,27 @@ func TestOnOrderUpdate(t *testing.T) {
 		t.Error("Callback should not be called without connection")
 	}
 }
+
+func TestNewAccountDataStream_Environment(t *testing.T) {
+	stream := NewAccountDataStream("key/1", WithEnvironment(environment.Demo))
+	if want := environment.Demo.SwapAccountStreamURL + "?listenKey=key%2F1"; stream.url != want {
+		t.Errorf("expected %s, got %s", want, stream.url)
+	}
+
+	stream = NewAccountDataStream("key", WithMarket(environment.Spot))
+	if want := environment.Production.SpotAccountStreamURL + "?listenKey=key"; stream.url != want {
+		t.Errorf("expected %s, got %s", want, stream.url)
+	}
+
+	if got := NewAccountDataStream("key").url; got != AccountDataStreamBaseURL+"?listenKey=key" {
+		t.Errorf("default should stay on the production swap stream, got %s", got)
+	}
+}
+
+func TestNewAccountDataStream_NoDemoSpotStream(t *testing.T) {
+	stream := NewAccountDataStream("key", WithEnvironment(environment.Demo), WithMarket(environment.Spot))
+	err := stream.Connect()
+	if err == nil || !strings.Contains(err.Error(), "no spot account stream") {
+		t.Fatalf("Connect() = %v, want the missing stream error", err)
+	}
+}
@@ -0,0 +1,164 @@
+package websocket
+
+import (
+	"encoding/json"
+
+	"github.com/tigusigalpa/bingx-go/v2/decimal"
+)
+
+// Account stream event types, the "e" field of account data frames.
+const (
+	EventOrderTradeUpdate    = "ORDER_TRADE_UPDATE"
+	EventAccountUpdate       = "ACCOUNT_UPDATE"
+	EventAccountConfigUpdate = "ACCOUNT_CONFIG_UPDATE"
+	EventListenKeyExpired    = "listenKeyExpired"
+)
+
+// Execution types of an OrderUpdate.
+const (
+	ExecutionNew        = "NEW"
+	ExecutionCanceled   = "CANCELED"
+	ExecutionCalculated = "CALCULATED"
+	ExecutionExpired    = "EXPIRED"
+	ExecutionTrade      = "TRADE"
+)
+
+// Order statuses of an OrderUpdate.
+const (
+	OrderStatusNew             = "NEW"
+	OrderStatusPartiallyFilled = "PARTIALLY_FILLED"
+	OrderStatusFilled          = "FILLED"
+	OrderStatusCanceled        = "CANCELED"
+	OrderStatusExpired         = "EXPIRED"
+)
+
+// OrderTradeUpdate is an ORDER_TRADE_UPDATE event, sent when an order is
+// created, filled, cancelled or expires. Times are Unix milliseconds.
+type OrderTradeUpdate struct {
+	EventType string      `json:"e"`
+	EventTime int64       `json:"E"`
+	Order     OrderUpdate `json:"o"`
+}
+
+// OrderUpdate is the order carried by an OrderTradeUpdate.
+type OrderUpdate struct {
+	Symbol        string          `json:"s"`
+	ClientOrderID string          `json:"c"`
+	OrderID       json.Number     `json:"i"`
+	Side          string          `json:"S"`
+	PositionSide  string          `json:"ps"`
+	Type          string          `json:"o"`
+	Quantity      decimal.Decimal `json:"q"`
+	Price         decimal.Decimal `json:"p"`
+	StopPrice     decimal.Decimal `json:"sp"`
+	WorkingType   string          `json:"wt"`
+	// ExecutionType is one of the Execution constants.
+	ExecutionType string `json:"x"`
+	// Status is one of the OrderStatus constants.
+	Status          string          `json:"X"`
+	AveragePrice    decimal.Decimal `json:"ap"`
+	FilledQuantity  decimal.Decimal `json:"z"`
+	RealizedProfit  decimal.Decimal `json:"rp"`
+	Commission      decimal.Decimal `json:"n"`
+	CommissionAsset string          `json:"N"`
+	TradeTime       int64           `json:"T"`
+}
+
+// AccountUpdate is an ACCOUNT_UPDATE event, sent when balances or
+// positions change. Times are Unix milliseconds.
+type AccountUpdate struct {
+	EventType string            `json:"e"`
+	EventTime int64             `json:"E"`
+	Account   AccountUpdateData `json:"a"`
+}
+
+// AccountUpdateData holds the changed balances and positions of an
+// AccountUpdate.
+type AccountUpdateData struct {
+	// Reason is the cause of the update, e.g. "ORDER", "FUNDING_FEE" or
+	// "DEPOSIT".
+	Reason    string           `json:"m"`
+	Balances  []BalanceUpdate  `json:"B"`
+	Positions []PositionUpdate `json:"P"`
+}
+
+// BalanceUpdate is one asset balance of an AccountUpdate.
+type BalanceUpdate struct {
+	Asset              string          `json:"a"`
+	WalletBalance      decimal.Decimal `json:"wb"`
+	CrossWalletBalance decimal.Decimal `json:"cw"`
+	// BalanceChange is the change of the wallet balance, excluding
+	// realized profit and commission.
+	BalanceChange decimal.Decimal `json:"bc"`
+}
+
+// PositionUpdate is one position of an AccountUpdate.
+type PositionUpdate struct {
+	Symbol                string          `json:"s"`
+	PositionSide          string          `json:"ps"`
+	PositionAmount        decimal.Decimal `json:"pa"`
+	EntryPrice            decimal.Decimal `json:"ep"`
+	UnrealizedProfit      decimal.Decimal `json:"up"`
+	AccumulatedRealized   decimal.Decimal `json:"cr"`
+	MarginType            string          `json:"mt"`
+	IsolatedWalletBalance decimal.Decimal `json:"iw"`
+}
+
+// AccountConfigUpdate is an ACCOUNT_CONFIG_UPDATE event, sent when the
+// leverage or margin mode of a symbol changes. Times are Unix milliseconds.
+type AccountConfigUpdate struct {
+	EventType string        `json:"e"`
+	EventTime int64         `json:"E"`
+	Config    AccountConfig `json:"ac"`
+}
+
+// AccountConfig is the new configuration of a symbol in an
+// AccountConfigUpdate.
+type AccountConfig struct {
+	Symbol        string `json:"s"`
+	LongLeverage  int    `json:"l"`
+	ShortLeverage int    `json:"S"`
+	MarginType    string `json:"mt"`
+}
+
+// routes returns the stream's router, registering it with the client on
+// first use.
+func (a *AccountDataStream) routes() *eventRouter {
+	return a.eventRoutes(&a.router, "e")
+}
+
+// OnOrderTradeUpdate calls handler for every ORDER_TRADE_UPDATE event.
+func (a *AccountDataStream) OnOrderTradeUpdate(handler func(OrderTradeUpdate)) {
+	a.routes().add(EventOrderTradeUpdate, func(frame []byte) error {
+		var event OrderTradeUpdate
+		if err := json.Unmarshal(frame, &event); err != nil {
+			return err
+		}
+		handler(event)
+		return nil
+	})
+}
+
+// OnAccountUpdateEvent calls handler for every ACCOUNT_UPDATE event.
+func (a *AccountDataStream) OnAccountUpdateEvent(handler func(AccountUpdate)) {
+	a.routes().add(EventAccountUpdate, func(frame []byte) error {
+		var event AccountUpdate
+		if err := json.Unmarshal(frame, &event); err != nil {
+			return err
+		}
+		handler(event)
+		return nil
+	})
+}
+
+// OnAccountConfigUpdate calls handler for every ACCOUNT_CONFIG_UPDATE event.
+func (a *AccountDataStream) OnAccountConfigUpdate(handler func(AccountConfigUpdate)) {
+	a.routes().add(EventAccountConfigUpdate, func(frame []byte) error {
+		var event AccountConfigUpdate
+		if err := json.Unmarshal(frame, &event); err != nil {
+			return err
+		}
+		handler(event)
+		return nil
+	})
+}
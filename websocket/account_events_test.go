@@ -0,0 +1,127 @@
+package websocket
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// Payload samples as sent by the USDT-M account stream.
+const (
+	orderTradeUpdateFrame = `{"e":"ORDER_TRADE_UPDATE","E":1702961468766,"o":{"s":"BTC-USDT","c":"my-order-1","i":1736995893457362944,"S":"SELL","o":"MARKET","q":"0.00100000","p":"42431.30000000","sp":"0.00000000","ap":"42431.30000000","x":"TRADE","X":"FILLED","N":"USDT","n":"-0.02121565","T":1702961468754,"wt":"MARK_PRICE","ps":"SHORT","rp":"1.25000000","z":"0.00100000"}}`
+	newOrderFrame         = `{"e":"ORDER_TRADE_UPDATE","E":1702961460000,"o":{"s":"BTC-USDT","c":"my-order-2","i":1736995893457362945,"S":"BUY","o":"LIMIT","q":"0.00100000","p":"40000.00000000","sp":"0.00000000","ap":"","x":"NEW","X":"NEW","N":"USDT","n":"0.00000000","T":1702961460000,"wt":"MARK_PRICE","ps":"LONG","rp":"0.00000000","z":"0.00000000"}}`
+	accountUpdateFrame    = `{"e":"ACCOUNT_UPDATE","E":1676603102163,"a":{"m":"ORDER","B":[{"a":"USDT","wb":"5.76487226","cw":"5.76487226","bc":"-0.02121565"}],"P":[{"s":"LINK-USDT","pa":"1.00000000","ep":"7.25620000","up":"0.00000000","mt":"isolated","iw":"2.42020000","ps":"LONG","cr":"-0.00300000"}]}}`
+	accountConfigFrame    = `{"e":"ACCOUNT_CONFIG_UPDATE","E":1676603102163,"ac":{"s":"BTC-USDT","l":12,"S":8,"mt":"cross"}}`
+)
+
+func TestAccountDataStream_TypedEvents(t *testing.T) {
+	stream := NewAccountDataStream("key", WithEnvironment(frameEnvironment(t,
+		orderTradeUpdateFrame, accountUpdateFrame, accountConfigFrame,
+	)))
+
+	var orders []OrderTradeUpdate
+	var accounts []AccountUpdate
+	var configs []AccountConfigUpdate
+	stream.OnOrderTradeUpdate(func(event OrderTradeUpdate) { orders = append(orders, event) })
+	stream.OnAccountUpdateEvent(func(event AccountUpdate) { accounts = append(accounts, event) })
+	stream.OnAccountConfigUpdate(func(event AccountConfigUpdate) { configs = append(configs, event) })
+
+	if err := stream.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = stream.Disconnect() }()
+	_ = stream.Listen()
+
+	if len(orders) != 1 || len(accounts) != 1 || len(configs) != 1 {
+		t.Fatalf("got %d order, %d account and %d config events, want one each", len(orders), len(accounts), len(configs))
+	}
+
+	wantOrder := OrderTradeUpdate{
+		EventType: EventOrderTradeUpdate,
+		EventTime: 1702961468766,
+		Order: OrderUpdate{
+			Symbol:          "BTC-USDT",
+			ClientOrderID:   "my-order-1",
+			OrderID:         json.Number("1736995893457362944"),
+			Side:            "SELL",
+			PositionSide:    "SHORT",
+			Type:            "MARKET",
+			Quantity:        "0.00100000",
+			Price:           "42431.30000000",
+			StopPrice:       "0.00000000",
+			WorkingType:     "MARK_PRICE",
+			ExecutionType:   ExecutionTrade,
+			Status:          OrderStatusFilled,
+			AveragePrice:    "42431.30000000",
+			FilledQuantity:  "0.00100000",
+			RealizedProfit:  "1.25000000",
+			Commission:      "-0.02121565",
+			CommissionAsset: "USDT",
+			TradeTime:       1702961468754,
+		},
+	}
+	if orders[0] != wantOrder {
+		t.Errorf("order event = %+v\nwant %+v", orders[0], wantOrder)
+	}
+
+	account := accounts[0]
+	if account.EventType != EventAccountUpdate || account.EventTime != 1676603102163 || account.Account.Reason != "ORDER" {
+		t.Errorf("account event = %+v", account)
+	}
+	wantBalance := BalanceUpdate{Asset: "USDT", WalletBalance: "5.76487226", CrossWalletBalance: "5.76487226", BalanceChange: "-0.02121565"}
+	if len(account.Account.Balances) != 1 || account.Account.Balances[0] != wantBalance {
+		t.Errorf("balances = %+v, want %+v", account.Account.Balances, wantBalance)
+	}
+	wantPosition := PositionUpdate{
+		Symbol:                "LINK-USDT",
+		PositionSide:          "LONG",
+		PositionAmount:        "1.00000000",
+		EntryPrice:            "7.25620000",
+		UnrealizedProfit:      "0.00000000",
+		AccumulatedRealized:   "-0.00300000",
+		MarginType:            "isolated",
+		IsolatedWalletBalance: "2.42020000",
+	}
+	if len(account.Account.Positions) != 1 || account.Account.Positions[0] != wantPosition {
+		t.Errorf("positions = %+v, want %+v", account.Account.Positions, wantPosition)
+	}
+
+	wantConfig := AccountConfigUpdate{
+		EventType: EventAccountConfigUpdate,
+		EventTime: 1676603102163,
+		Config:    AccountConfig{Symbol: "BTC-USDT", LongLeverage: 12, ShortLeverage: 8, MarginType: "cross"},
+	}
+	if configs[0] != wantConfig {
+		t.Errorf("config event = %+v, want %+v", configs[0], wantConfig)
+	}
+}
+
+func TestAccountDataStream_NewOrderWithoutAveragePrice(t *testing.T) {
+	stream := NewAccountDataStream("key", WithEnvironment(frameEnvironment(t, newOrderFrame)))
+	var orders []OrderTradeUpdate
+	stream.OnOrderTradeUpdate(func(event OrderTradeUpdate) { orders = append(orders, event) })
+
+	if err := stream.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = stream.Disconnect() }()
+	_ = stream.Listen()
+
+	if len(orders) != 1 {
+		t.Fatalf("got %d order events, want 1", len(orders))
+	}
+	order := orders[0].Order
+	if order.Status != OrderStatusNew || order.AveragePrice != "" || !order.AveragePrice.IsZero() || order.Price != "40000.00000000" {
+		t.Errorf("order = %+v", order)
+	}
+}
+
+func TestOrderUpdate_DecodesStringOrderID(t *testing.T) {
+	var event OrderTradeUpdate
+	frame := `{"e":"ORDER_TRADE_UPDATE","E":1,"o":{"s":"BTC-USDT","i":"1736995893457362944","X":"NEW","x":"NEW"}}`
+	if err := json.Unmarshal([]byte(frame), &event); err != nil {
+		t.Fatal(err)
+	}
+	if event.Order.OrderID != "1736995893457362944" || event.Order.Status != OrderStatusNew {
+		t.Errorf("order = %+v", event.Order)
+	}
+}
@@ -14,10 +14,15 @@ import (
 
 type MessageCallback func(data map[string]interface{})
 
+// frameRouter decodes a raw frame into typed events for the handlers a
+// stream registered. parsed is the same frame as delivered to callbacks.
+type frameRouter func(frame []byte, parsed map[string]interface{}) error
+
 type WebSocketClient struct {
 	url       string
 	conn      *websocket.Conn
 	callbacks []MessageCallback
+	routers   []frameRouter
 	running   bool
 	mu        sync.RWMutex
 	// writeMu serializes WriteMessage calls. gorilla/websocket allows only
@@ -25,20 +30,59 @@ type WebSocketClient struct {
 	// long network write can't pin the state mutex and block reconnects.
 	writeMu sync.Mutex
 	done    chan struct{}
+
+	// safeURL is url with secrets redacted, for hooks.
+	safeURL string
+	// urlErr, when set, explains why there is no endpoint to dial, e.g. an
+	// account stream the environment does not offer.
+	urlErr      error
+	hooks       []Hooks
+	connects    int
+	sessionOpen bool
+	state       ConnectionState
+
+	// subscriptions is the subscription registry, in the order the
+	// subscriptions were made. Supervise replays it on every new connection.
+	subscriptions []Subscription
+	// pendingAcks are the requests waiting for an ack, by request ID.
+	pendingAcks map[string]pendingAck
+	ackTimeout  time.Duration
+	// reading is set while Listen runs, so requests know an ack will be read.
+	reading bool
+	// healthy is set once the current session read its first frame.
+	// Supervise resets its backoff only after a healthy session.
+	healthy bool
+	// supervisorStop is closed by Disconnect to end a running Supervise.
+	supervisorStop chan struct{}
 }
 
 func NewWebSocketClient(url string) *WebSocketClient {
 	return &WebSocketClient{
 		url:       url,
+		safeURL:   redactURL(url),
 		callbacks: make([]MessageCallback, 0),
 		done:      make(chan struct{}),
 	}
 }
 
 func (c *WebSocketClient) Connect() error {
+	if c.IsConnected() {
+		return nil
+	}
+
+	c.setState(StateConnecting, nil)
+	if err := c.dial(); err != nil {
+		c.setState(StateClosed, err)
+		return err
+	}
+	c.setState(StateConnected, nil)
+	return nil
+}
+
+func (c *WebSocketClient) dial() error {
 	c.mu.Lock()
-	defer c.mu.Unlock()
 	if c.conn != nil {
+		c.mu.Unlock()
 		return nil
 	}
 
@@ -46,12 +90,24 @@ func (c *WebSocketClient) Connect() error {
 		HandshakeTimeout: 60 * time.Second,
 	}
 
-	conn, _, err := dialer.Dial(c.url, nil)
+	reconnect := c.connects > 0
+	err := c.urlErr
+	var conn *websocket.Conn
+	if err == nil {
+		conn, _, err = dialer.Dial(c.url, nil)
+	}
 	if err != nil {
-		return fmt.Errorf("failed to connect to WebSocket: %w", err)
+		hooks := c.hooks
+		c.mu.Unlock()
+		err = fmt.Errorf("failed to connect to WebSocket: %w", err)
+		c.emitConnect(hooks, reconnect, err)
+		return err
 	}
 
 	c.conn = conn
+	c.connects++
+	c.sessionOpen = true
+	c.healthy = false
 	// Disconnect closes done to wake a listener. A fresh connection needs a
 	// fresh signal channel so the client can be reused.
 	select {
@@ -59,12 +115,22 @@ func (c *WebSocketClient) Connect() error {
 		c.done = make(chan struct{})
 	default:
 	}
+	hooks := c.hooks
+	c.mu.Unlock()
+
+	c.emitConnect(hooks, reconnect, nil)
 	return nil
 }
 
-func (c *WebSocketClient) Disconnect() error {
+// setURL changes the endpoint of the next dial, e.g. to a new listen key.
+func (c *WebSocketClient) setURL(url string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	c.url = url
+}
+
+func (c *WebSocketClient) Disconnect() error {
+	c.mu.Lock()
 
 	c.running = false
 	select {
@@ -74,13 +140,55 @@ func (c *WebSocketClient) Disconnect() error {
 		close(c.done)
 	}
 
+	if c.supervisorStop != nil {
+		close(c.supervisorStop)
+		c.supervisorStop = nil
+	}
+
+	var err error
 	if c.conn != nil {
-		err := c.conn.Close()
+		err = c.conn.Close()
 		c.conn = nil
-		return err
 	}
 
-	return nil
+	ended := c.sessionOpen
+	c.sessionOpen = false
+	closed := c.state != StateClosed
+	c.state = StateClosed
+	hooks := c.hooks
+	c.mu.Unlock()
+
+	c.failPendingAcks(nil)
+	if ended {
+		c.emitClose(hooks, nil)
+	}
+	if closed {
+		c.emitState(hooks, StateClosed, nil)
+	}
+	return err
+}
+
+// endSession reports the end of the session on conn unless Disconnect or
+// a newer connection already superseded it.
+// Outside Supervise the client then reports StateClosed; Supervise reports
+// StateReconnecting itself.
+func (c *WebSocketClient) endSession(conn *websocket.Conn, err error) {
+	c.mu.Lock()
+	ended := c.sessionOpen && c.conn == conn
+	if ended {
+		c.sessionOpen = false
+	}
+	supervised := c.supervisorStop != nil
+	hooks := c.hooks
+	c.mu.Unlock()
+
+	if ended {
+		c.failPendingAcks(err)
+		c.emitClose(hooks, err)
+		if !supervised {
+			c.setState(StateClosed, err)
+		}
+	}
 }
 
 func (c *WebSocketClient) Send(message map[string]interface{}) error {
@@ -105,22 +213,6 @@ func (c *WebSocketClient) Send(message map[string]interface{}) error {
 	return conn.WriteMessage(websocket.TextMessage, data)
 }
 
-func (c *WebSocketClient) Subscribe(id, dataType string) error {
-	return c.Send(map[string]interface{}{
-		"id":       id,
-		"reqType":  "sub",
-		"dataType": dataType,
-	})
-}
-
-func (c *WebSocketClient) Unsubscribe(id, dataType string) error {
-	return c.Send(map[string]interface{}{
-		"id":       id,
-		"reqType":  "unsub",
-		"dataType": dataType,
-	})
-}
-
 func (c *WebSocketClient) OnMessage(callback MessageCallback) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -134,10 +226,18 @@ func (c *WebSocketClient) Listen() error {
 		return fmt.Errorf("WebSocket client is not connected")
 	}
 	c.running = true
+	c.reading = true
 	conn := c.conn
 	done := c.done
 	c.mu.Unlock()
 
+	defer func() {
+		c.mu.Lock()
+		c.reading = false
+		c.mu.Unlock()
+	}()
+
+	first := true
 	for c.isRunning() {
 		select {
 		case <-done:
@@ -146,37 +246,59 @@ func (c *WebSocketClient) Listen() error {
 			messageType, message, err := conn.ReadMessage()
 			if err != nil {
 				if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-					return fmt.Errorf("WebSocket connection closed unexpectedly: %w", err)
+					err = fmt.Errorf("WebSocket connection closed unexpectedly: %w", err)
 				}
+				c.endSession(conn, err)
 				return err
 			}
+			if first {
+				first = false
+				c.markHealthy(conn)
+			}
 
 			if messageType == websocket.BinaryMessage || messageType == websocket.TextMessage {
 				data, err := c.decompressMessage(message)
 				if err != nil {
+					c.emitDrop(c.hookList(), fmt.Errorf("failed to decompress message: %w", err))
 					continue
 				}
 
 				var parsed map[string]interface{}
 				if err := json.Unmarshal(data, &parsed); err != nil {
+					c.emitDrop(c.hookList(), fmt.Errorf("failed to decode message: %w", err))
 					continue
 				}
 
 				if ping, ok := parsed["ping"]; ok {
 					if err := c.Send(map[string]interface{}{"pong": ping}); err != nil {
-						return fmt.Errorf("failed to respond to WebSocket ping: %w", err)
+						err = fmt.Errorf("failed to respond to WebSocket ping: %w", err)
+						c.endSession(conn, err)
+						return err
 					}
 					continue
 				}
 
+				if c.handleAck(parsed) {
+					continue
+				}
+
 				c.mu.RLock()
 				callbacks := make([]MessageCallback, len(c.callbacks))
 				copy(callbacks, c.callbacks)
+				routers := c.routers
+				hooks := c.hooks
 				c.mu.RUnlock()
 
+				c.emitMessage(hooks, parsed)
+
 				for _, callback := range callbacks {
 					callback(parsed)
 				}
+				for _, route := range routers {
+					if err := route(data, parsed); err != nil {
+						c.emitDrop(hooks, err)
+					}
+				}
 			}
 		}
 	}
@@ -184,6 +306,15 @@ func (c *WebSocketClient) Listen() error {
 	return nil
 }
 
+// markHealthy records that the session on conn delivered a frame.
+func (c *WebSocketClient) markHealthy(conn *websocket.Conn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn == conn {
+		c.healthy = true
+	}
+}
+
 func (c *WebSocketClient) decompressMessage(message []byte) ([]byte, error) {
 	if len(message) >= 2 && message[0] == 0x1f && message[1] == 0x8b {
 		reader, err := gzip.NewReader(bytes.NewReader(message))
@@ -0,0 +1,93 @@
+package websocket
+
+import "net/url"
+
+// Hooks observe the lifecycle of a WebSocketClient, e.g. for logging or
+// metrics. Any field may be nil. Hooks run synchronously on the goroutine
+// that triggered them and must not block. The url argument has the
+// listenKey query parameter redacted.
+type Hooks struct {
+	// OnConnect runs after every dial attempt. reconnect is true when the
+	// client had been connected before.
+	OnConnect func(url string, reconnect bool, err error)
+	// OnClose runs once when a connected session ends: with nil after
+	// Disconnect, or with the read error that ended Listen.
+	OnClose func(url string, err error)
+	// OnMessage runs for every decoded message delivered to callbacks.
+	OnMessage func(url string, data map[string]interface{})
+	// OnDrop runs when a frame cannot be decompressed or decoded and is
+	// therefore not delivered, or when a typed handler's payload cannot be
+	// decoded.
+	OnDrop func(url string, err error)
+	// OnStateChange runs when the connection state changes. err is the
+	// cause of StateReconnecting, or of StateClosed after a failure.
+	OnStateChange func(url string, state ConnectionState, err error)
+}
+
+// AddHooks registers lifecycle hooks on the client.
+func (c *WebSocketClient) AddHooks(hooks Hooks) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.hooks = append(c.hooks, hooks)
+}
+
+func (c *WebSocketClient) hookList() []Hooks {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.hooks
+}
+
+func (c *WebSocketClient) emitConnect(hooks []Hooks, reconnect bool, err error) {
+	for _, h := range hooks {
+		if h.OnConnect != nil {
+			h.OnConnect(c.safeURL, reconnect, err)
+		}
+	}
+}
+
+func (c *WebSocketClient) emitClose(hooks []Hooks, err error) {
+	for _, h := range hooks {
+		if h.OnClose != nil {
+			h.OnClose(c.safeURL, err)
+		}
+	}
+}
+
+func (c *WebSocketClient) emitMessage(hooks []Hooks, data map[string]interface{}) {
+	for _, h := range hooks {
+		if h.OnMessage != nil {
+			h.OnMessage(c.safeURL, data)
+		}
+	}
+}
+
+func (c *WebSocketClient) emitDrop(hooks []Hooks, err error) {
+	for _, h := range hooks {
+		if h.OnDrop != nil {
+			h.OnDrop(c.safeURL, err)
+		}
+	}
+}
+
+func (c *WebSocketClient) emitState(hooks []Hooks, state ConnectionState, err error) {
+	for _, h := range hooks {
+		if h.OnStateChange != nil {
+			h.OnStateChange(c.safeURL, state, err)
+		}
+	}
+}
+
+// redactURL hides the listenKey, which grants access to the account stream.
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	query := u.Query()
+	if query.Get("listenKey") == "" {
+		return raw
+	}
+	query.Set("listenKey", "[REDACTED]")
+	u.RawQuery = query.Encode()
+	return u.String()
+}
@@ -0,0 +1,175 @@
+package websocket
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+type hookRecorder struct {
+	mu         sync.Mutex
+	connects   []bool
+	closes     []error
+	messages   int
+	drops      int
+	urls       []string
+	connectErr error
+}
+
+func (r *hookRecorder) hooks() Hooks {
+	return Hooks{
+		OnConnect: func(url string, reconnect bool, err error) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.urls = append(r.urls, url)
+			if err != nil {
+				r.connectErr = err
+				return
+			}
+			r.connects = append(r.connects, reconnect)
+		},
+		OnClose: func(url string, err error) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.closes = append(r.closes, err)
+		},
+		OnMessage: func(url string, data map[string]interface{}) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.messages++
+		},
+		OnDrop: func(url string, err error) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.drops++
+		},
+	}
+}
+
+func TestHooks_ReportSessionLifecycle(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"dataType":"BTC-USDT@trade"}`))
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`not json`))
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"ping":"1"}`))
+		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "bye"))
+	}))
+	defer srv.Close()
+
+	rec := &hookRecorder{}
+	c := NewWebSocketClient("ws" + strings.TrimPrefix(srv.URL, "http") + "?listenKey=secret-key")
+	c.AddHooks(rec.hooks())
+
+	if err := c.Connect(); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	if err := c.Listen(); err == nil {
+		t.Fatal("expected Listen to return the close error")
+	}
+	if err := c.Disconnect(); err != nil {
+		t.Fatalf("disconnect: %v", err)
+	}
+	if err := c.Connect(); err != nil {
+		t.Fatalf("reconnect: %v", err)
+	}
+	if err := c.Disconnect(); err != nil {
+		t.Fatalf("disconnect: %v", err)
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if len(rec.connects) != 2 || rec.connects[0] || !rec.connects[1] {
+		t.Errorf("unexpected connect events: %v", rec.connects)
+	}
+	if len(rec.closes) != 2 || rec.closes[0] == nil || rec.closes[1] != nil {
+		t.Errorf("expected one error close and one clean close, got %v", rec.closes)
+	}
+	if rec.messages != 1 || rec.drops != 1 {
+		t.Errorf("expected 1 message and 1 drop, got %d and %d", rec.messages, rec.drops)
+	}
+	for _, u := range rec.urls {
+		if strings.Contains(u, "secret-key") {
+			t.Errorf("listenKey leaked to hooks: %s", u)
+		}
+	}
+}
+
+func TestHooks_ReportFailedPong(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		// Ping once the client can no longer write.
+		_, _, _ = conn.ReadMessage()
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"ping":"1"}`))
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer srv.Close()
+
+	rec := &hookRecorder{}
+	c := NewWebSocketClient("ws" + strings.TrimPrefix(srv.URL, "http"))
+	c.AddHooks(rec.hooks())
+	if err := c.Connect(); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	defer func() { _ = c.Disconnect() }()
+	if err := c.conn.UnderlyingConn().(*net.TCPConn).CloseWrite(); err != nil {
+		t.Fatal(err)
+	}
+
+	err := c.Listen()
+	if err == nil || !strings.Contains(err.Error(), "ping") {
+		t.Fatalf("Listen() = %v, want the failed pong", err)
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if len(rec.closes) != 1 || rec.closes[0] != err {
+		t.Errorf("OnClose errors = %v, want [%v]", rec.closes, err)
+	}
+	if c.State() != StateClosed {
+		t.Errorf("state = %s, want closed", c.State())
+	}
+}
+
+func TestHooks_ReportFailedDial(t *testing.T) {
+	rec := &hookRecorder{}
+	c := NewWebSocketClient("ws://127.0.0.1:1/unreachable")
+	c.AddHooks(rec.hooks())
+
+	if err := c.Connect(); err == nil {
+		t.Fatal("expected dial error")
+	}
+	if rec.connectErr == nil {
+		t.Error("OnConnect should receive the dial error")
+	}
+	if err := c.Disconnect(); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.closes) != 0 {
+		t.Error("OnClose must not run for a session that never opened")
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	got := redactURL("wss://open-api-swap.bingx.com/swap-market?listenKey=abc123")
+	if strings.Contains(got, "abc123") || !strings.Contains(got, "listenKey=%5BREDACTED%5D") {
+		t.Errorf("unexpected redacted URL %s", got)
+	}
+	if plain := "wss://open-api-swap.bingx.com/swap-market"; redactURL(plain) != plain {
+		t.Error("URLs without a listenKey must be unchanged")
+	}
+}
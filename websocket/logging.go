@@ -0,0 +1,86 @@
+package websocket
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogLevels selects the level of each kind of record written by a logger
+// registered with AddLogger.
+type LogLevels struct {
+	Connect   slog.Level
+	Reconnect slog.Level
+	Close     slog.Level
+	Message   slog.Level
+	Drop      slog.Level
+	// Failure is used for failed dials and sessions that end with an error.
+	Failure slog.Level
+}
+
+// DefaultLogLevels logs session changes at info, reconnects and drops at
+// warn, failures at error and individual messages at debug level.
+func DefaultLogLevels() LogLevels {
+	return LogLevels{
+		Connect:   slog.LevelInfo,
+		Reconnect: slog.LevelWarn,
+		Close:     slog.LevelInfo,
+		Message:   slog.LevelDebug,
+		Drop:      slog.LevelWarn,
+		Failure:   slog.LevelError,
+	}
+}
+
+// AddLogger writes a structured record for connects, reconnects, closes,
+// messages and dropped frames to logger. URLs have the listenKey redacted and
+// message payloads are not logged, only their dataType or event type.
+func (c *WebSocketClient) AddLogger(logger *slog.Logger, levels LogLevels) {
+	ctx := context.Background()
+	c.AddHooks(Hooks{
+		OnConnect: func(url string, reconnect bool, err error) {
+			switch {
+			case err != nil:
+				logger.LogAttrs(ctx, levels.Failure, "bingx websocket connect failed",
+					slog.String("url", url), slog.Bool("reconnect", reconnect), slog.String("error", err.Error()))
+			case reconnect:
+				logger.LogAttrs(ctx, levels.Reconnect, "bingx websocket reconnected", slog.String("url", url))
+			default:
+				logger.LogAttrs(ctx, levels.Connect, "bingx websocket connected", slog.String("url", url))
+			}
+		},
+		OnClose: func(url string, err error) {
+			if err != nil {
+				logger.LogAttrs(ctx, levels.Failure, "bingx websocket closed",
+					slog.String("url", url), slog.String("error", err.Error()))
+				return
+			}
+			logger.LogAttrs(ctx, levels.Close, "bingx websocket closed", slog.String("url", url))
+		},
+		OnMessage: func(url string, data map[string]interface{}) {
+			if !logger.Enabled(ctx, levels.Message) {
+				return
+			}
+			attrs := []slog.Attr{slog.String("url", url)}
+			if dataType, ok := data["dataType"].(string); ok {
+				attrs = append(attrs, slog.String("dataType", dataType))
+			}
+			if event, ok := data["e"].(string); ok {
+				attrs = append(attrs, slog.String("event", event))
+			}
+			logger.LogAttrs(ctx, levels.Message, "bingx websocket message", attrs...)
+		},
+		OnDrop: func(url string, err error) {
+			logger.LogAttrs(ctx, levels.Drop, "bingx websocket message dropped",
+				slog.String("url", url), slog.String("error", err.Error()))
+		},
+		OnStateChange: func(url string, state ConnectionState, err error) {
+			if state != StateReconnecting {
+				return
+			}
+			attrs := []slog.Attr{slog.String("url", url)}
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+			}
+			logger.LogAttrs(ctx, levels.Reconnect, "bingx websocket reconnecting", attrs...)
+		},
+	})
+}
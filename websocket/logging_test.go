@@ -0,0 +1,62 @@
+package websocket
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestAddLogger_RedactsAndUsesLevels(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client := NewWebSocketClient("wss://open-api-ws.bingx.com/market?listenKey=secret-listen-key")
+	client.AddLogger(logger, DefaultLogLevels())
+
+	hooks := client.hookList()
+	client.emitConnect(hooks, false, nil)
+	client.emitConnect(hooks, true, nil)
+	client.emitMessage(hooks, map[string]interface{}{"dataType": "BTC-USDT@trade", "data": "payload"})
+	client.emitDrop(hooks, errors.New("failed to decode message"))
+	client.emitClose(hooks, errors.New("connection reset"))
+
+	out := buf.String()
+	for _, want := range []string{
+		`level=INFO msg="bingx websocket connected"`,
+		`level=WARN msg="bingx websocket reconnected"`,
+		`level=DEBUG msg="bingx websocket message"`,
+		`dataType=BTC-USDT@trade`,
+		`level=WARN msg="bingx websocket message dropped"`,
+		`level=ERROR msg="bingx websocket closed"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log output missing %s:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "secret-listen-key") || strings.Contains(out, "payload") {
+		t.Errorf("log output leaks the listenKey or payload:\n%s", out)
+	}
+}
+
+func TestAddLogger_ReconnectingWithoutError(t *testing.T) {
+	var buf bytes.Buffer
+	client := NewWebSocketClient("wss://open-api-ws.bingx.com/market")
+	client.AddLogger(slog.New(slog.NewTextHandler(&buf, nil)), DefaultLogLevels())
+
+	hooks := client.hookList()
+	client.emitState(hooks, StateReconnecting, nil)
+	client.emitState(hooks, StateReconnecting, errors.New("connection reset"))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 records, got:\n%s", buf.String())
+	}
+	if strings.Contains(lines[0], "error=") {
+		t.Errorf("record without an error has an error attribute: %s", lines[0])
+	}
+	if !strings.Contains(lines[1], `error="connection reset"`) {
+		t.Errorf("record is missing the error: %s", lines[1])
+	}
+}
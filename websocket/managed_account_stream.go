@@ -0,0 +1,202 @@
+package websocket
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultKeepAliveInterval is how often a ManagedAccountStream extends its
+// listen key. BingX expires listen keys 60 minutes after the last extension.
+const DefaultKeepAliveInterval = 30 * time.Minute
+
+// pendingListenKey stands in for the listen key until Run creates one, so
+// that hooks see the same redacted URL before and after.
+const pendingListenKey = "pending"
+
+var errListenKeyRenewed = fmt.Errorf("listen key renewed")
+
+// ManagedAccountStream is an account data stream that owns its listen key:
+// it creates the key, extends it periodically, replaces it when it expires
+// and deletes it on shutdown. Register handlers on the embedded
+// AccountDataStream before calling Run; they survive key renewals.
+type ManagedAccountStream struct {
+	*AccountDataStream
+
+	// KeepAliveInterval is how often the listen key is extended. Zero means
+	// DefaultKeepAliveInterval.
+	KeepAliveInterval time.Duration
+	// OnError, if set, receives listen key errors that Run recovers from,
+	// such as a failed extension or deletion.
+	OnError func(err error)
+
+	keys    ListenKeySource
+	baseURL string
+	renew   chan struct{}
+
+	mu        sync.RWMutex
+	listenKey string
+}
+
+// ListenKeySource creates, extends and deletes the listen keys of an account
+// stream. The bingx package adapts its REST listen key services with
+// bingx.USDTMListenKeys, bingx.CoinMListenKeys and bingx.TradFiListenKeys.
+type ListenKeySource interface {
+	Create(ctx context.Context) (string, error)
+	Extend(ctx context.Context, listenKey string) error
+	Delete(ctx context.Context, listenKey string) error
+}
+
+// NewManagedAccountStream creates a production USDT-M account stream whose
+// listen keys are managed with keys, unless WithEnvironment or WithMarket say
+// otherwise. Pass the listen keys of the matching product line for the other
+// markets. If the environment has no account stream for the market, Run
+// returns the error of environment.AccountStreamURL.
+func NewManagedAccountStream(keys ListenKeySource, opts ...StreamOption) *ManagedAccountStream {
+	cfg := newStreamConfig(opts)
+	baseURL, err := cfg.env.AccountStreamURL(cfg.market)
+	client := NewWebSocketClient(accountStreamURL(baseURL, pendingListenKey))
+	client.urlErr = err
+	m := &ManagedAccountStream{
+		AccountDataStream: &AccountDataStream{WebSocketClient: client},
+		keys:              keys,
+		baseURL:           baseURL,
+		renew:             make(chan struct{}, 1),
+	}
+	m.routes().add(EventListenKeyExpired, func(frame []byte) error {
+		m.requestRenewal()
+		return nil
+	})
+	return m
+}
+
+// ListenKey returns the listen key in use, or "" before Run created one.
+func (m *ManagedAccountStream) ListenKey() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.listenKey
+}
+
+// Run creates a listen key and delivers events like Supervise, extending the
+// key every KeepAliveInterval. When an extension fails or the server sends a
+// listenKeyExpired event, Run creates a new key and reconnects with it.
+//
+// Run blocks until ctx is cancelled, Disconnect or Stop is called, or policy
+// gives up, and returns like Supervise. The listen key is deleted before Run
+// returns.
+func (m *ManagedAccountStream) Run(ctx context.Context, policy ReconnectPolicy) error {
+	if m.urlErr != nil {
+		return m.urlErr
+	}
+	listenKey, err := m.keys.Create(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create listen key: %w", err)
+	}
+	m.useKey(listenKey)
+
+	keepAliveCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		m.keepAlive(keepAliveCtx, policy)
+	}()
+
+	err = m.Supervise(ctx, policy)
+	cancel()
+	<-done
+
+	// ctx is usually cancelled by now, but the key should still be deleted.
+	deleteCtx, cancelDelete := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancelDelete()
+	if deleteErr := m.keys.Delete(deleteCtx, m.ListenKey()); deleteErr != nil {
+		m.reportError(fmt.Errorf("failed to delete listen key: %w", deleteErr))
+	}
+	return err
+}
+
+// keepAlive extends the listen key on a timer and renews it on request
+// until ctx ends. A failed renewal is retried with the backoff of policy.
+func (m *ManagedAccountStream) keepAlive(ctx context.Context, policy ReconnectPolicy) {
+	ticker := time.NewTicker(m.keepAliveInterval())
+	defer ticker.Stop()
+
+	var retry <-chan time.Time
+	failures := 0
+	renew := func() {
+		if m.renewKey(ctx) {
+			failures = 0
+			retry = nil
+			return
+		}
+		failures++
+		retry = time.After(policy.delay(failures))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.keys.Extend(ctx, m.ListenKey()); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				m.reportError(fmt.Errorf("failed to extend listen key: %w", err))
+				renew()
+			}
+		case <-m.renew:
+			renew()
+		case <-retry:
+			renew()
+		}
+	}
+}
+
+// renewKey replaces the listen key and drops the connection, so that
+// Supervise reconnects with the new key. The old key is deleted.
+func (m *ManagedAccountStream) renewKey(ctx context.Context) bool {
+	listenKey, err := m.keys.Create(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			m.reportError(fmt.Errorf("failed to renew listen key: %w", err))
+		}
+		return false
+	}
+
+	old := m.ListenKey()
+	m.useKey(listenKey)
+	m.dropConnection(errListenKeyRenewed)
+	if old != listenKey {
+		// The old key has usually expired already.
+		_ = m.keys.Delete(ctx, old)
+	}
+	return true
+}
+
+func (m *ManagedAccountStream) requestRenewal() {
+	select {
+	case m.renew <- struct{}{}:
+	default:
+	}
+}
+
+func (m *ManagedAccountStream) useKey(listenKey string) {
+	m.mu.Lock()
+	m.listenKey = listenKey
+	m.mu.Unlock()
+	m.setURL(accountStreamURL(m.baseURL, listenKey))
+}
+
+func (m *ManagedAccountStream) keepAliveInterval() time.Duration {
+	if m.KeepAliveInterval <= 0 {
+		return DefaultKeepAliveInterval
+	}
+	return m.KeepAliveInterval
+}
+
+func (m *ManagedAccountStream) reportError(err error) {
+	if m.OnError != nil {
+		m.OnError(err)
+	}
+}
@@ -0,0 +1,194 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/tigusigalpa/bingx-go/v2/environment"
+)
+
+// fakeListenKeys hands out key-1, key-2, ... and records every call.
+type fakeListenKeys struct {
+	mu        sync.Mutex
+	created   int
+	extended  []string
+	deleted   []string
+	extendErr func(listenKey string) error
+}
+
+func (f *fakeListenKeys) Create(ctx context.Context) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.created++
+	return fmt.Sprintf("key-%d", f.created), nil
+}
+
+func (f *fakeListenKeys) Extend(ctx context.Context, listenKey string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.extended = append(f.extended, listenKey)
+	if f.extendErr != nil {
+		return f.extendErr(listenKey)
+	}
+	return nil
+}
+
+func (f *fakeListenKeys) Delete(ctx context.Context, listenKey string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleted = append(f.deleted, listenKey)
+	return nil
+}
+
+func (f *fakeListenKeys) calls() (created int, extended, deleted []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.created, append([]string(nil), f.extended...), append([]string(nil), f.deleted...)
+}
+
+// newAccountServer runs an account stream server. session is called with
+// the listenKey of every connection and returns the frames to send; the
+// connection then stays open until the client closes it.
+func newAccountServer(t *testing.T, session func(listenKey string) []string) environment.Environment {
+	t.Helper()
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		listenKey := r.URL.Query().Get("listenKey")
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		for _, frame := range session(listenKey) {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(frame)); err != nil {
+				return
+			}
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return environment.Custom("test", "", "ws"+strings.TrimPrefix(srv.URL, "http"))
+}
+
+func TestManagedAccountStream_RenewsExpiredKey(t *testing.T) {
+	var mu sync.Mutex
+	var sessions []string
+	env := newAccountServer(t, func(listenKey string) []string {
+		mu.Lock()
+		sessions = append(sessions, listenKey)
+		mu.Unlock()
+		if listenKey == "key-1" {
+			return []string{`{"e":"listenKeyExpired","E":1676603102163,"listenKey":"key-1"}`}
+		}
+		return []string{orderTradeUpdateFrame}
+	})
+
+	keys := &fakeListenKeys{}
+	stream := NewManagedAccountStream(keys, WithEnvironment(env))
+	orders := make(chan OrderTradeUpdate, 1)
+	stream.OnOrderTradeUpdate(func(event OrderTradeUpdate) { orders <- event })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error, 1)
+	go func() { result <- stream.Run(ctx, fastReconnectPolicy()) }()
+
+	select {
+	case event := <-orders:
+		if event.Order.Symbol != "BTC-USDT" {
+			t.Errorf("order event = %+v", event)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("no order event after the listen key was renewed")
+	}
+	if got := stream.ListenKey(); got != "key-2" {
+		t.Errorf("ListenKey() = %q, want key-2", got)
+	}
+
+	cancel()
+	if err := <-result; !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() = %v, want context.Canceled", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if got := strings.Join(sessions, ","); got != "key-1,key-2" {
+		t.Errorf("sessions used keys %s, want key-1,key-2", got)
+	}
+	created, _, deleted := keys.calls()
+	if created != 2 {
+		t.Errorf("created %d keys, want 2", created)
+	}
+	if got := strings.Join(deleted, ","); got != "key-1,key-2" {
+		t.Errorf("deleted keys %s, want key-1,key-2", got)
+	}
+}
+
+func TestManagedAccountStream_ExtendsAndRenewsOnFailure(t *testing.T) {
+	env := newAccountServer(t, func(listenKey string) []string { return nil })
+
+	keys := &fakeListenKeys{extendErr: func(listenKey string) error {
+		if listenKey == "key-1" {
+			return errors.New("listen key does not exist")
+		}
+		return nil
+	}}
+	stream := NewManagedAccountStream(keys, WithEnvironment(env))
+	stream.KeepAliveInterval = 5 * time.Millisecond
+	var reported []error
+	var reportedMu sync.Mutex
+	stream.OnError = func(err error) {
+		reportedMu.Lock()
+		defer reportedMu.Unlock()
+		reported = append(reported, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error, 1)
+	go func() { result <- stream.Run(ctx, fastReconnectPolicy()) }()
+
+	waitFor(t, func() bool {
+		_, extended, _ := keys.calls()
+		for _, key := range extended {
+			if key == "key-2" {
+				return true
+			}
+		}
+		return false
+	})
+	waitFor(t, func() bool { return stream.State() == StateConnected })
+
+	cancel()
+	if err := <-result; !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() = %v, want context.Canceled", err)
+	}
+	reportedMu.Lock()
+	defer reportedMu.Unlock()
+	if len(reported) == 0 || !strings.Contains(reported[0].Error(), "failed to extend listen key") {
+		t.Errorf("reported errors = %v, want the failed extension", reported)
+	}
+}
+
+func TestManagedAccountStream_NoDemoCoinMStream(t *testing.T) {
+	keys := &fakeListenKeys{}
+	stream := NewManagedAccountStream(keys, WithEnvironment(environment.Demo), WithMarket(environment.CoinM))
+	err := stream.Run(context.Background(), fastReconnectPolicy())
+	if err == nil || !strings.Contains(err.Error(), "no coin-M account stream") {
+		t.Fatalf("Run() = %v, want the missing stream error", err)
+	}
+	if created, _, _ := keys.calls(); created != 0 {
+		t.Errorf("created %d listen keys for a stream that does not exist", created)
+	}
+}
@@ -2,6 +2,7 @@ package websocket
 
 import (
 	"fmt"
+	"sync/atomic"
 	"time"
 )
 
@@ -10,11 +11,18 @@ const MarketDataStreamURL = "wss://open-api-swap.bingx.com/swap-market"
 
 type MarketDataStream struct {
 	*WebSocketClient
+
+	// router dispatches frames to the typed On... handlers. It is created
+	// on first use, guarded by the client's mu.
+	router *eventRouter
 }
 
-func NewMarketDataStream() *MarketDataStream {
+// NewMarketDataStream creates a production USDT-M market data stream unless
+// WithEnvironment or WithMarket say otherwise.
+func NewMarketDataStream(opts ...StreamOption) *MarketDataStream {
+	cfg := newStreamConfig(opts)
 	return &MarketDataStream{
-		WebSocketClient: NewWebSocketClient(MarketDataStreamURL),
+		WebSocketClient: NewWebSocketClient(cfg.env.MarketStreamURL(cfg.market)),
 	}
 }
 
@@ -68,9 +76,13 @@ func (m *MarketDataStream) UnsubscribeBookTicker(symbol string, id ...string) er
 	return m.Unsubscribe(requestID, fmt.Sprintf("%s@bookTicker", symbol))
 }
 
+// idSequence keeps generated request IDs unique when several are created
+// within the clock resolution, since acks are matched by ID.
+var idSequence atomic.Uint64
+
 func (m *MarketDataStream) generateID(id ...string) string {
 	if len(id) > 0 && id[0] != "" {
 		return id[0]
 	}
-	return fmt.Sprintf("bingx_%d", time.Now().UnixNano())
+	return fmt.Sprintf("bingx_%d_%d", time.Now().UnixNano(), idSequence.Add(1))
 }
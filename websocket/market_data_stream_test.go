@@ -3,6 +3,8 @@ package websocket
 import (
 	"strings"
 	"testing"
+
+	"github.com/tigusigalpa/bingx-go/v2/environment"
 )
 
 func TestNewMarketDataStream(t *testing.T) {
@@ -224,3 +226,17 @@ func TestUnsubscribeBookTicker(t *testing.T) {
 		t.Skip("Skipping test - would require WebSocket connection")
 	}
 }
+
+func TestNewMarketDataStream_Environment(t *testing.T) {
+	if got := NewMarketDataStream().url; got != MarketDataStreamURL {
+		t.Errorf("default should stay on %s, got %s", MarketDataStreamURL, got)
+	}
+	if got := NewMarketDataStream(WithMarket(environment.CoinM)).url; got != environment.Production.CoinMMarketStreamURL {
+		t.Errorf("unexpected coin-M URL %s", got)
+	}
+
+	mock := environment.Custom("mock", "http://127.0.0.1:1", "ws://127.0.0.1:2/ws")
+	if got := NewMarketDataStream(WithEnvironment(mock), WithMarket(environment.Spot)).url; got != "ws://127.0.0.1:2/ws" {
+		t.Errorf("unexpected custom URL %s", got)
+	}
+}
@@ -0,0 +1,233 @@
+package websocket
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tigusigalpa/bingx-go/v2/decimal"
+)
+
+// Trade is a public trade from a "<symbol>@trade" stream. Times are Unix
+// milliseconds.
+type Trade struct {
+	Symbol       string          `json:"s"`
+	Price        decimal.Decimal `json:"p"`
+	Quantity     decimal.Decimal `json:"q"`
+	Time         int64           `json:"T"`
+	BuyerIsMaker bool            `json:"m"`
+}
+
+// Kline is a candlestick from a "<symbol>@kline_<interval>" stream.
+type Kline struct {
+	Symbol   string          `json:"-"`
+	Interval string          `json:"-"`
+	Open     decimal.Decimal `json:"o"`
+	High     decimal.Decimal `json:"h"`
+	Low      decimal.Decimal `json:"l"`
+	Close    decimal.Decimal `json:"c"`
+	Volume   decimal.Decimal `json:"v"`
+	// Time is the candle time in Unix milliseconds.
+	Time int64 `json:"T"`
+}
+
+// PriceLevel is one order book level. It decodes from ["price","qty"] as
+// well as from {"p":"price","a":"qty"}.
+type PriceLevel struct {
+	Price    decimal.Decimal
+	Quantity decimal.Decimal
+}
+
+func (l *PriceLevel) UnmarshalJSON(data []byte) error {
+	var pair []decimal.Decimal
+	if err := json.Unmarshal(data, &pair); err == nil {
+		if len(pair) < 2 {
+			return fmt.Errorf("price level needs a price and a quantity, got %s", data)
+		}
+		l.Price, l.Quantity = pair[0], pair[1]
+		return nil
+	}
+
+	var level struct {
+		Price    decimal.Decimal `json:"p"`
+		Amount   decimal.Decimal `json:"a"`
+		Quantity decimal.Decimal `json:"v"`
+	}
+	if err := json.Unmarshal(data, &level); err != nil {
+		return err
+	}
+	l.Price, l.Quantity = level.Price, level.Amount
+	if l.Quantity == "" {
+		l.Quantity = level.Quantity
+	}
+	return nil
+}
+
+// Depth is an order book snapshot from a "<symbol>@depth<levels>" stream.
+type Depth struct {
+	Symbol string       `json:"-"`
+	Levels int          `json:"-"`
+	Bids   []PriceLevel `json:"bids"`
+	Asks   []PriceLevel `json:"asks"`
+}
+
+// Ticker is a 24 hour rolling window summary from a "<symbol>@ticker"
+// stream. Times are Unix milliseconds.
+type Ticker struct {
+	EventType          string          `json:"e"`
+	Symbol             string          `json:"s"`
+	EventTime          int64           `json:"E"`
+	PriceChange        decimal.Decimal `json:"p"`
+	PriceChangePercent decimal.Decimal `json:"P"`
+	LastPrice          decimal.Decimal `json:"c"`
+	LastQuantity       decimal.Decimal `json:"L"`
+	OpenPrice          decimal.Decimal `json:"o"`
+	HighPrice          decimal.Decimal `json:"h"`
+	LowPrice           decimal.Decimal `json:"l"`
+	Volume             decimal.Decimal `json:"v"`
+	QuoteVolume        decimal.Decimal `json:"q"`
+	OpenTime           int64           `json:"O"`
+	CloseTime          int64           `json:"C"`
+	BidPrice           decimal.Decimal `json:"B"`
+	BidQuantity        decimal.Decimal `json:"b"`
+	AskPrice           decimal.Decimal `json:"A"`
+	AskQuantity        decimal.Decimal `json:"a"`
+}
+
+// BookTicker is the best bid and ask from a "<symbol>@bookTicker" stream.
+// Times are Unix milliseconds.
+type BookTicker struct {
+	EventType       string          `json:"e"`
+	Symbol          string          `json:"s"`
+	UpdateID        int64           `json:"u"`
+	EventTime       int64           `json:"E"`
+	TransactionTime int64           `json:"T"`
+	BidPrice        decimal.Decimal `json:"b"`
+	BidQuantity     decimal.Decimal `json:"B"`
+	AskPrice        decimal.Decimal `json:"a"`
+	AskQuantity     decimal.Decimal `json:"A"`
+}
+
+// decodeEach decodes data, which BingX sends either as one object or as an
+// array of objects, and calls fn for every element.
+func decodeEach[T any](data json.RawMessage, fn func(T)) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var items []T
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		for _, item := range items {
+			fn(item)
+		}
+		return nil
+	}
+
+	var item T
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	fn(item)
+	return nil
+}
+
+// routes returns the stream's router, registering it with the client on
+// first use.
+func (m *MarketDataStream) routes() *eventRouter {
+	return m.eventRoutes(&m.router, "dataType")
+}
+
+// streamData returns the data field of a market data frame.
+func streamData(frame []byte) (json.RawMessage, error) {
+	var envelope struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(frame, &envelope); err != nil {
+		return nil, err
+	}
+	return envelope.Data, nil
+}
+
+// OnTrade calls handler for every trade of symbol. Subscribe with
+// SubscribeTrade to receive them.
+func (m *MarketDataStream) OnTrade(symbol string, handler func(Trade)) {
+	m.routes().add(fmt.Sprintf("%s@trade", symbol), func(frame []byte) error {
+		data, err := streamData(frame)
+		if err != nil {
+			return err
+		}
+		return decodeEach(data, func(trade Trade) {
+			if trade.Symbol == "" {
+				trade.Symbol = symbol
+			}
+			handler(trade)
+		})
+	})
+}
+
+// OnKline calls handler for every candle of symbol and interval. Subscribe
+// with SubscribeKline to receive them.
+func (m *MarketDataStream) OnKline(symbol, interval string, handler func(Kline)) {
+	m.routes().add(fmt.Sprintf("%s@kline_%s", symbol, interval), func(frame []byte) error {
+		data, err := streamData(frame)
+		if err != nil {
+			return err
+		}
+		return decodeEach(data, func(kline Kline) {
+			kline.Symbol, kline.Interval = symbol, interval
+			handler(kline)
+		})
+	})
+}
+
+// OnDepth calls handler for every order book snapshot of symbol with the
+// given number of levels. Subscribe with SubscribeDepth to receive them.
+func (m *MarketDataStream) OnDepth(symbol string, levels int, handler func(Depth)) {
+	m.routes().add(fmt.Sprintf("%s@depth%d", symbol, levels), func(frame []byte) error {
+		data, err := streamData(frame)
+		if err != nil {
+			return err
+		}
+		var depth Depth
+		if err := json.Unmarshal(data, &depth); err != nil {
+			return err
+		}
+		depth.Symbol, depth.Levels = symbol, levels
+		handler(depth)
+		return nil
+	})
+}
+
+// OnTicker calls handler for every 24 hour ticker update of symbol.
+// Subscribe with SubscribeTicker to receive them.
+func (m *MarketDataStream) OnTicker(symbol string, handler func(Ticker)) {
+	m.routes().add(fmt.Sprintf("%s@ticker", symbol), func(frame []byte) error {
+		data, err := streamData(frame)
+		if err != nil {
+			return err
+		}
+		return decodeEach(data, func(ticker Ticker) {
+			if ticker.Symbol == "" {
+				ticker.Symbol = symbol
+			}
+			handler(ticker)
+		})
+	})
+}
+
+// OnBookTicker calls handler for every best bid and ask update of symbol.
+// Subscribe with SubscribeBookTicker to receive them.
+func (m *MarketDataStream) OnBookTicker(symbol string, handler func(BookTicker)) {
+	m.routes().add(fmt.Sprintf("%s@bookTicker", symbol), func(frame []byte) error {
+		data, err := streamData(frame)
+		if err != nil {
+			return err
+		}
+		return decodeEach(data, func(ticker BookTicker) {
+			if ticker.Symbol == "" {
+				ticker.Symbol = symbol
+			}
+			handler(ticker)
+		})
+	})
+}
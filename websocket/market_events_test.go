@@ -0,0 +1,131 @@
+package websocket
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/tigusigalpa/bingx-go/v2/decimal"
+	"github.com/tigusigalpa/bingx-go/v2/environment"
+)
+
+// frameServer sends frames to every connection and then closes it. It
+// returns a stream connected to the server.
+func frameServer(t *testing.T, frames ...string) *MarketDataStream {
+	t.Helper()
+	stream := NewMarketDataStream(WithEnvironment(frameEnvironment(t, frames...)))
+	if err := stream.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = stream.Disconnect() })
+	return stream
+}
+
+// frameEnvironment starts a server that sends frames to every connection
+// and then closes it.
+func frameEnvironment(t *testing.T, frames ...string) environment.Environment {
+	t.Helper()
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		for _, frame := range frames {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(frame)); err != nil {
+				return
+			}
+		}
+		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	}))
+	t.Cleanup(srv.Close)
+
+	return environment.Custom("test", "", "ws"+strings.TrimPrefix(srv.URL, "http"))
+}
+
+func TestMarketDataStream_TypedHandlers(t *testing.T) {
+	stream := frameServer(t,
+		`{"code":0,"dataType":"BTC-USDT@trade","data":[{"q":"0.0010","p":"29683.3","T":1702876006163,"m":true,"s":"BTC-USDT"},{"q":"0.02","p":"29683.4","T":1702876006164,"m":false,"s":"BTC-USDT"}]}`,
+		`{"code":0,"dataType":"ETH-USDT@trade","data":[{"q":"1","p":"2000","T":1,"m":true,"s":"ETH-USDT"}]}`,
+		`{"code":0,"dataType":"BTC-USDT@kline_1m","s":"BTC-USDT","data":[{"c":"29690.1","o":"29680.0","h":"29700.0","l":"29670.5","v":"12.5","T":1702876020000}]}`,
+		`{"code":0,"dataType":"BTC-USDT@depth5","data":{"bids":[["29683.3","1.5"],["29683.2","0.1"]],"asks":[{"p":"29683.4","a":"2"}]}}`,
+		`{"code":0,"dataType":"BTC-USDT@ticker","data":{"e":"24hTicker","E":1702876006000,"s":"BTC-USDT","p":"-100.5","P":"-0.34","c":"29683.3","L":"0.001","h":"30000","l":"29500","v":"1000","q":"29700000","o":"29783.8","O":1702789606000,"C":1702876006000,"B":"29683.3","b":"1.5","A":"29683.4","a":"2"}}`,
+		`{"code":0,"dataType":"BTC-USDT@bookTicker","data":{"e":"bookTicker","u":42,"E":1702876006001,"T":1702876006000,"s":"BTC-USDT","b":"29683.3","B":"1.5","a":"29683.4","A":"2"}}`,
+	)
+
+	var trades []Trade
+	var klines []Kline
+	var depths []Depth
+	var tickers []Ticker
+	var bookTickers []BookTicker
+	stream.OnTrade("BTC-USDT", func(trade Trade) { trades = append(trades, trade) })
+	stream.OnKline("BTC-USDT", "1m", func(kline Kline) { klines = append(klines, kline) })
+	stream.OnDepth("BTC-USDT", 5, func(depth Depth) { depths = append(depths, depth) })
+	stream.OnTicker("BTC-USDT", func(ticker Ticker) { tickers = append(tickers, ticker) })
+	stream.OnBookTicker("BTC-USDT", func(ticker BookTicker) { bookTickers = append(bookTickers, ticker) })
+
+	_ = stream.Listen()
+
+	wantTrades := []Trade{
+		{Symbol: "BTC-USDT", Price: "29683.3", Quantity: "0.0010", Time: 1702876006163, BuyerIsMaker: true},
+		{Symbol: "BTC-USDT", Price: "29683.4", Quantity: "0.02", Time: 1702876006164},
+	}
+	if len(trades) != len(wantTrades) || trades[0] != wantTrades[0] || trades[1] != wantTrades[1] {
+		t.Errorf("trades = %+v, want %+v", trades, wantTrades)
+	}
+
+	wantKline := Kline{Symbol: "BTC-USDT", Interval: "1m", Open: "29680.0", High: "29700.0", Low: "29670.5", Close: "29690.1", Volume: "12.5", Time: 1702876020000}
+	if len(klines) != 1 || klines[0] != wantKline {
+		t.Errorf("klines = %+v, want %+v", klines, wantKline)
+	}
+
+	if len(depths) != 1 {
+		t.Fatalf("got %d depth updates, want 1", len(depths))
+	}
+	depth := depths[0]
+	if depth.Symbol != "BTC-USDT" || depth.Levels != 5 || len(depth.Bids) != 2 || len(depth.Asks) != 1 {
+		t.Fatalf("depth = %+v", depth)
+	}
+	if depth.Bids[0] != (PriceLevel{Price: "29683.3", Quantity: "1.5"}) || depth.Asks[0] != (PriceLevel{Price: "29683.4", Quantity: "2"}) {
+		t.Errorf("depth levels = %+v / %+v", depth.Bids, depth.Asks)
+	}
+
+	if len(tickers) != 1 {
+		t.Fatalf("got %d tickers, want 1", len(tickers))
+	}
+	ticker := tickers[0]
+	if ticker.PriceChange != "-100.5" || ticker.PriceChangePercent != "-0.34" || ticker.LastPrice != "29683.3" ||
+		ticker.BidPrice != "29683.3" || ticker.BidQuantity != "1.5" || ticker.AskPrice != "29683.4" || ticker.AskQuantity != "2" ||
+		ticker.OpenTime != 1702789606000 || ticker.CloseTime != 1702876006000 {
+		t.Errorf("ticker = %+v", ticker)
+	}
+
+	wantBook := BookTicker{EventType: "bookTicker", Symbol: "BTC-USDT", UpdateID: 42, EventTime: 1702876006001, TransactionTime: 1702876006000,
+		BidPrice: "29683.3", BidQuantity: "1.5", AskPrice: "29683.4", AskQuantity: decimal.Decimal("2")}
+	if len(bookTickers) != 1 || bookTickers[0] != wantBook {
+		t.Errorf("book tickers = %+v, want %+v", bookTickers, wantBook)
+	}
+}
+
+func TestMarketDataStream_TypedHandlerDecodeErrorIsDropped(t *testing.T) {
+	stream := frameServer(t, `{"dataType":"BTC-USDT@trade","data":[{"p":"not a number"}]}`)
+	var dropped []error
+	stream.OnTrade("BTC-USDT", func(trade Trade) { t.Errorf("unexpected trade %+v", trade) })
+
+	var delivered int
+	stream.OnMessage(func(map[string]interface{}) { delivered++ })
+
+	stream.AddHooks(Hooks{OnDrop: func(url string, err error) { dropped = append(dropped, err) }})
+	_ = stream.Listen()
+
+	if delivered != 1 {
+		t.Errorf("OnMessage ran %d times, want 1", delivered)
+	}
+	if len(dropped) != 1 || !strings.Contains(dropped[0].Error(), "BTC-USDT@trade") {
+		t.Errorf("dropped = %v, want one BTC-USDT@trade decode error", dropped)
+	}
+}
@@ -0,0 +1,34 @@
+package websocket
+
+import "github.com/tigusigalpa/bingx-go/v2/environment"
+
+// StreamOption configures the endpoint of a market or account data stream.
+type StreamOption func(*streamConfig)
+
+type streamConfig struct {
+	env    environment.Environment
+	market environment.Market
+}
+
+func newStreamConfig(opts []StreamOption) streamConfig {
+	cfg := streamConfig{env: environment.Production, market: environment.Swap}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// WithEnvironment connects to the streams of env instead of production.
+func WithEnvironment(env environment.Environment) StreamOption {
+	return func(c *streamConfig) {
+		c.env = env
+	}
+}
+
+// WithMarket selects the product line of the stream. The default is
+// environment.Swap (USDT-margined perpetual futures).
+func WithMarket(market environment.Market) StreamOption {
+	return func(c *streamConfig) {
+		c.market = market
+	}
+}
@@ -0,0 +1,276 @@
+package websocket
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ConnectionState is the lifecycle state reported to Hooks.OnStateChange.
+type ConnectionState int
+
+const (
+	// StateClosed means there is no connection and none is being made.
+	StateClosed ConnectionState = iota
+	// StateConnecting is reported before the first dial.
+	StateConnecting
+	// StateConnected is reported once a connection is open and, under
+	// Supervise, the active subscriptions were sent again.
+	StateConnected
+	// StateReconnecting is reported by Supervise when the connection
+	// dropped or a dial failed and another dial is scheduled.
+	StateReconnecting
+)
+
+func (s ConnectionState) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateConnecting:
+		return "connecting"
+	case StateConnected:
+		return "connected"
+	case StateReconnecting:
+		return "reconnecting"
+	default:
+		return fmt.Sprintf("ConnectionState(%d)", int(s))
+	}
+}
+
+// ReconnectPolicy controls how Supervise dials again after a disconnect.
+type ReconnectPolicy struct {
+	// MaxAttempts is the number of consecutive failed dials after which
+	// Supervise gives up. A session that closes before delivering a frame
+	// counts as a failed dial. Zero retries forever.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first reconnect.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the exponential delay. Zero means no cap.
+	MaxBackoff time.Duration
+	// Multiplier grows the delay between consecutive failed dials. Values
+	// below 1 are treated as 1.
+	Multiplier float64
+	// Jitter randomly shortens each delay by up to this fraction (0..1).
+	Jitter float64
+}
+
+// DefaultReconnectPolicy retries forever with exponential backoff from
+// 500ms up to 30s.
+func DefaultReconnectPolicy() ReconnectPolicy {
+	return ReconnectPolicy{
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// delay returns the wait before the given reconnect attempt, starting at 1.
+func (p ReconnectPolicy) delay(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	backoff := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		backoff -= backoff * jitter * rand.Float64()
+	}
+
+	return time.Duration(backoff)
+}
+
+// State returns the current connection state.
+func (c *WebSocketClient) State() ConnectionState {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.state
+}
+
+// Supervise connects if needed and delivers messages like Listen, but
+// survives disconnects: when the connection drops it dials again according
+// to policy and replays every active subscription before delivering further
+// messages. Progress is reported through Hooks.OnStateChange.
+//
+// Supervise blocks until ctx is cancelled, Disconnect or Stop is called, or
+// policy.MaxAttempts consecutive dials fail. It returns ctx.Err() after
+// cancellation, nil after Disconnect or Stop, and the last dial or session
+// error when it gives up.
+func (c *WebSocketClient) Supervise(ctx context.Context, policy ReconnectPolicy) error {
+	c.mu.Lock()
+	if c.supervisorStop != nil {
+		c.mu.Unlock()
+		return fmt.Errorf("WebSocket client is already supervised")
+	}
+	stop := make(chan struct{})
+	c.supervisorStop = stop
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		if c.supervisorStop == stop {
+			c.supervisorStop = nil
+		}
+		c.mu.Unlock()
+	}()
+	defer context.AfterFunc(ctx, func() { _ = c.Disconnect() })()
+	// finish makes sure StateClosed is reported even when Supervise returns
+	// before the AfterFunc above ran.
+	finish := func() error {
+		_ = c.Disconnect()
+		return ctx.Err()
+	}
+
+	// failures counts consecutive failed dials and sessions that closed
+	// before delivering a frame against MaxAttempts. backoff is the step of
+	// the next delay. Both start over only after a healthy session, so a
+	// server that accepts and immediately closes is backed off like one that
+	// refuses the dial.
+	failures, backoff := 0, 0
+	for {
+		if stopped(stop) {
+			return finish()
+		}
+
+		if !c.IsConnected() {
+			if backoff == 0 {
+				c.setState(StateConnecting, nil)
+			}
+			if err := c.connectAndResubscribe(); err != nil {
+				failures++
+				if policy.MaxAttempts > 0 && failures >= policy.MaxAttempts {
+					c.setState(StateClosed, err)
+					return fmt.Errorf("WebSocket reconnect gave up after %d attempts: %w", failures, err)
+				}
+				backoff++
+				c.setState(StateReconnecting, err)
+				if !sleep(ctx, stop, policy.delay(backoff)) {
+					return finish()
+				}
+				continue
+			}
+			c.setState(StateConnected, nil)
+		}
+
+		err := c.Listen()
+		if stopped(stop) {
+			return finish()
+		}
+		if err == nil {
+			// Stop was called.
+			return nil
+		}
+
+		healthy := c.sessionHealthy()
+		c.dropConnection(err)
+		if healthy {
+			failures, backoff = 0, 0
+		} else {
+			failures++
+			if policy.MaxAttempts > 0 && failures >= policy.MaxAttempts {
+				c.setState(StateClosed, err)
+				return fmt.Errorf("WebSocket reconnect gave up after %d attempts: %w", failures, err)
+			}
+		}
+		backoff++
+		c.setState(StateReconnecting, err)
+		if !sleep(ctx, stop, policy.delay(backoff)) {
+			return finish()
+		}
+	}
+}
+
+// sessionHealthy reports whether the current session read a frame.
+func (c *WebSocketClient) sessionHealthy() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.healthy
+}
+
+// connectAndResubscribe dials and sends the active subscriptions again.
+func (c *WebSocketClient) connectAndResubscribe() error {
+	if err := c.dial(); err != nil {
+		return err
+	}
+
+	c.mu.RLock()
+	subscriptions := make([]Subscription, len(c.subscriptions))
+	copy(subscriptions, c.subscriptions)
+	c.mu.RUnlock()
+
+	// The acks are read by the next Listen and mark the registry entries
+	// acknowledged again.
+	for _, sub := range subscriptions {
+		err := c.Send(map[string]interface{}{
+			"id":       sub.ID,
+			"reqType":  "sub",
+			"dataType": sub.DataType,
+		})
+		if err != nil {
+			err = fmt.Errorf("failed to resubscribe to %s: %w", sub.DataType, err)
+			c.dropConnection(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// dropConnection ends the current session with err and closes its
+// connection, so that the next dial opens a new one.
+func (c *WebSocketClient) dropConnection(err error) {
+	c.mu.RLock()
+	conn := c.conn
+	c.mu.RUnlock()
+	if conn == nil {
+		return
+	}
+
+	c.endSession(conn, err)
+
+	c.mu.Lock()
+	if c.conn == conn {
+		c.conn = nil
+	}
+	c.mu.Unlock()
+	_ = conn.Close()
+}
+
+func (c *WebSocketClient) setState(state ConnectionState, err error) {
+	c.mu.Lock()
+	c.state = state
+	hooks := c.hooks
+	c.mu.Unlock()
+
+	c.emitState(hooks, state, err)
+}
+
+func stopped(stop <-chan struct{}) bool {
+	select {
+	case <-stop:
+		return true
+	default:
+		return false
+	}
+}
+
+// sleep waits for d and reports false when ctx or stop ended the wait.
+func sleep(ctx context.Context, stop <-chan struct{}, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	case <-stop:
+		return false
+	}
+}
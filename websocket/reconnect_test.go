@@ -0,0 +1,263 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+type stateRecorder struct {
+	mu     sync.Mutex
+	states []ConnectionState
+}
+
+func (r *stateRecorder) hooks() Hooks {
+	return Hooks{OnStateChange: func(url string, state ConnectionState, err error) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.states = append(r.states, state)
+	}}
+}
+
+func (r *stateRecorder) String() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make([]string, len(r.states))
+	for i, s := range r.states {
+		names[i] = s.String()
+	}
+	return strings.Join(names, ",")
+}
+
+func fastReconnectPolicy() ReconnectPolicy {
+	return ReconnectPolicy{InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond, Multiplier: 2}
+}
+
+func TestSupervise_ReconnectsAndResubscribes(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	subs := make(chan string, 10)
+	var mu sync.Mutex
+	sessions := 0
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		mu.Lock()
+		sessions++
+		session := sessions
+		mu.Unlock()
+
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var frame map[string]interface{}
+		_ = json.Unmarshal(data, &frame)
+		subs <- frame["dataType"].(string)
+
+		if session == 1 {
+			// Drop the first session without a close frame, as BingX does.
+			return
+		}
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"dataType":"BTC-USDT@trade","data":{"p":"1"}}`))
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer srv.Close()
+
+	c := NewWebSocketClient("ws" + strings.TrimPrefix(srv.URL, "http"))
+	rec := &stateRecorder{}
+	c.AddHooks(rec.hooks())
+
+	received := make(chan struct{}, 1)
+	c.OnMessage(func(data map[string]interface{}) {
+		select {
+		case received <- struct{}{}:
+		default:
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error, 1)
+	go func() { result <- c.Supervise(ctx, fastReconnectPolicy()) }()
+
+	waitFor(t, func() bool { return c.State() == StateConnected })
+	if err := c.Subscribe("sub-1", "BTC-USDT@trade"); err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		select {
+		case got := <-subs:
+			if got != "BTC-USDT@trade" {
+				t.Errorf("session %d subscribed to %q", i+1, got)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("session %d was not subscribed", i+1)
+		}
+	}
+	select {
+	case <-received:
+	case <-time.After(2 * time.Second):
+		t.Fatal("no message after reconnect")
+	}
+
+	cancel()
+	if err := <-result; !errors.Is(err, context.Canceled) {
+		t.Errorf("Supervise() = %v, want context.Canceled", err)
+	}
+	if got, want := rec.String(), "connecting,connected,reconnecting,connected,closed"; got != want {
+		t.Errorf("states = %s, want %s", got, want)
+	}
+	if c.IsConnected() {
+		t.Error("client should be disconnected after cancellation")
+	}
+}
+
+func TestSupervise_GivesUpAfterMaxAttempts(t *testing.T) {
+	c := NewWebSocketClient("ws://127.0.0.1:1/unreachable")
+	rec := &stateRecorder{}
+	c.AddHooks(rec.hooks())
+
+	policy := fastReconnectPolicy()
+	policy.MaxAttempts = 3
+	err := c.Supervise(context.Background(), policy)
+	if err == nil || !strings.Contains(err.Error(), "gave up after 3 attempts") {
+		t.Fatalf("Supervise() = %v, want give-up error", err)
+	}
+	if got, want := rec.String(), "connecting,reconnecting,reconnecting,closed"; got != want {
+		t.Errorf("states = %s, want %s", got, want)
+	}
+}
+
+func TestSupervise_CountsFailedDialsAfterDrop(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	var mu sync.Mutex
+	dials := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		dials++
+		dial := dials
+		mu.Unlock()
+		if dial > 1 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		// Drop the only session that is accepted after it delivered a frame.
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"dataType":"BTC-USDT@trade","data":{"p":"1"}}`))
+		_ = conn.Close()
+	}))
+	defer srv.Close()
+
+	c := NewWebSocketClient("ws" + strings.TrimPrefix(srv.URL, "http"))
+	policy := fastReconnectPolicy()
+	policy.MaxAttempts = 2
+	err := c.Supervise(context.Background(), policy)
+	if err == nil || !strings.Contains(err.Error(), "gave up after 2 attempts") {
+		t.Fatalf("Supervise() = %v, want give-up error", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if dials != 3 {
+		t.Errorf("dialed %d times, want the first session and 2 failed reconnects", dials)
+	}
+}
+
+func TestSupervise_BacksOffWhenSessionsCloseImmediately(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	var mu sync.Mutex
+	var dials []time.Time
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		dials = append(dials, time.Now())
+		mu.Unlock()
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		// Accept every dial and close it before sending anything.
+		_ = conn.Close()
+	}))
+	defer srv.Close()
+
+	c := NewWebSocketClient("ws" + strings.TrimPrefix(srv.URL, "http"))
+	rec := &stateRecorder{}
+	c.AddHooks(rec.hooks())
+
+	policy := ReconnectPolicy{MaxAttempts: 3, InitialBackoff: 20 * time.Millisecond, Multiplier: 2}
+	err := c.Supervise(context.Background(), policy)
+	if err == nil || !strings.Contains(err.Error(), "gave up after 3 attempts") {
+		t.Fatalf("Supervise() = %v, want give-up error", err)
+	}
+	if got, want := rec.String(), "connecting,connected,reconnecting,connected,reconnecting,connected,closed"; got != want {
+		t.Errorf("states = %s, want %s", got, want)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(dials) != 3 {
+		t.Fatalf("dialed %d times, want 3", len(dials))
+	}
+	for i, want := range []time.Duration{policy.delay(1), policy.delay(2)} {
+		if gap := dials[i+1].Sub(dials[i]); gap < want {
+			t.Errorf("reconnect %d after %v, want at least %v", i+1, gap, want)
+		}
+	}
+}
+
+func TestSupervise_DisconnectEndsSupervision(t *testing.T) {
+	c := NewWebSocketClient("ws://127.0.0.1:1/unreachable")
+
+	result := make(chan error, 1)
+	go func() { result <- c.Supervise(context.Background(), DefaultReconnectPolicy()) }()
+
+	waitFor(t, func() bool { return c.State() == StateReconnecting })
+	if err := c.Subscribe("sub-1", "BTC-USDT@depth20"); err != nil {
+		t.Errorf("subscribing while reconnecting should be deferred, got %v", err)
+	}
+	if err := c.Disconnect(); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-result:
+		if err != nil {
+			t.Errorf("Supervise() = %v, want nil after Disconnect", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Supervise did not return after Disconnect")
+	}
+	if c.State() != StateClosed {
+		t.Errorf("state = %s, want closed", c.State())
+	}
+	if err := c.Supervise(context.Background(), ReconnectPolicy{MaxAttempts: 1}); err == nil {
+		t.Error("expected the dial error once supervision restarts")
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,53 @@
+package websocket
+
+import (
+	"fmt"
+	"sync"
+)
+
+// eventRouter dispatches frames to typed handlers by the string value of
+// keyField, e.g. "dataType" on market streams and "e" on account streams.
+type eventRouter struct {
+	keyField string
+	mu       sync.RWMutex
+	handlers map[string][]func(frame []byte) error
+}
+
+func (r *eventRouter) add(key string, handler func(frame []byte) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.handlers == nil {
+		r.handlers = make(map[string][]func(frame []byte) error)
+	}
+	r.handlers[key] = append(r.handlers[key], handler)
+}
+
+func (r *eventRouter) route(frame []byte, parsed map[string]interface{}) error {
+	key, _ := parsed[r.keyField].(string)
+	if key == "" {
+		return nil
+	}
+
+	r.mu.RLock()
+	handlers := r.handlers[key]
+	r.mu.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(frame); err != nil {
+			return fmt.Errorf("failed to decode %s message: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// eventRoutes returns *router, creating it and registering it with the
+// client on first use.
+func (c *WebSocketClient) eventRoutes(router **eventRouter, keyField string) *eventRouter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if *router == nil {
+		*router = &eventRouter{keyField: keyField}
+		c.routers = append(c.routers, (*router).route)
+	}
+	return *router
+}
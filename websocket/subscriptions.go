@@ -0,0 +1,314 @@
+package websocket
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	bxerrors "github.com/tigusigalpa/bingx-go/v2/errors"
+)
+
+// DefaultAckTimeout is how long Subscribe and Unsubscribe wait for the
+// server to acknowledge a request unless SetAckTimeout says otherwise.
+const DefaultAckTimeout = 5 * time.Second
+
+// Subscription is an entry of the subscription registry.
+type Subscription struct {
+	// ID is the request ID of the most recent subscribe frame.
+	ID       string
+	DataType string
+	// Acknowledged reports whether the server confirmed the subscription
+	// on the current connection.
+	Acknowledged bool
+}
+
+// SubscriptionError is returned when the server rejects a subscribe or
+// unsubscribe request with a non-zero code. errors.Is matches it against
+// the sentinel errors of the errors package, like errors.APIException.
+type SubscriptionError struct {
+	ID       string
+	DataType string
+	Code     string
+	Msg      string
+}
+
+func (e *SubscriptionError) Error() string {
+	return fmt.Sprintf("WebSocket request %s for %s rejected [%s]: %s", e.ID, e.DataType, e.Code, e.Msg)
+}
+
+// Is reports whether the code belongs to target's class, see
+// errors.ClassifyCode.
+func (e *SubscriptionError) Is(target error) bool {
+	return target != nil && target == bxerrors.ClassifyCode(e.Code)
+}
+
+// ack is the server's answer to a subscribe or unsubscribe request. err is
+// set instead when the connection ended before the answer arrived.
+type ack struct {
+	code string
+	msg  string
+	err  error
+}
+
+// pendingAck is a request waiting for its ack.
+type pendingAck struct {
+	dataType string
+	result   chan ack
+}
+
+// SetAckTimeout changes how long Subscribe and Unsubscribe wait for an
+// acknowledgement. Zero or a negative value restores DefaultAckTimeout.
+func (c *WebSocketClient) SetAckTimeout(timeout time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ackTimeout = timeout
+}
+
+// Subscriptions returns a snapshot of the subscription registry in the order
+// the subscriptions were made.
+func (c *WebSocketClient) Subscriptions() []Subscription {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	subscriptions := make([]Subscription, len(c.subscriptions))
+	copy(subscriptions, c.subscriptions)
+	return subscriptions
+}
+
+// Subscribe is SubscribeContext bounded by the ack timeout.
+func (c *WebSocketClient) Subscribe(id, dataType string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.getAckTimeout())
+	defer cancel()
+	return c.SubscribeContext(ctx, id, dataType)
+}
+
+// SubscribeContext asks the server for dataType and records it in the
+// subscription registry, so that Supervise can replay it after a reconnect.
+//
+// While Listen or Supervise is reading, it waits until the server
+// acknowledges the request or ctx ends, and returns a *SubscriptionError when
+// the server rejects it. Before Listen starts it returns once the request is
+// sent, and the registry is updated when the ack arrives later.
+//
+// Message callbacks run on the reading goroutine, which cannot read the ack
+// while it waits. Use SubscribeAsync there instead.
+//
+// While Supervise runs, a subscription that cannot be sent or acknowledged
+// because the connection is down is kept and sent on the next connection
+// instead of failing.
+func (c *WebSocketClient) SubscribeContext(ctx context.Context, id, dataType string) error {
+	return c.subscribe(ctx, id, dataType, true)
+}
+
+// SubscribeAsync is Subscribe without waiting for the acknowledgement, for
+// use in message callbacks. The registry shows whether the server accepted
+// the subscription; a rejected one is removed from it.
+func (c *WebSocketClient) SubscribeAsync(id, dataType string) error {
+	return c.subscribe(context.Background(), id, dataType, false)
+}
+
+func (c *WebSocketClient) subscribe(ctx context.Context, id, dataType string, wait bool) error {
+	c.mu.Lock()
+	found := false
+	for i := range c.subscriptions {
+		if c.subscriptions[i].DataType == dataType {
+			c.subscriptions[i].ID = id
+			c.subscriptions[i].Acknowledged = false
+			found = true
+			break
+		}
+	}
+	if !found {
+		c.subscriptions = append(c.subscriptions, Subscription{ID: id, DataType: dataType})
+	}
+	c.mu.Unlock()
+
+	err := c.request(ctx, "sub", id, dataType, wait)
+	if err == nil {
+		return nil
+	}
+
+	_, rejected := err.(*SubscriptionError)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch {
+	case rejected:
+		// handleAck already removed it from the registry.
+	case c.supervisorStop != nil:
+		return nil
+	case ctx.Err() == nil:
+		// Not sent, or the connection ended. A timed out request stays
+		// registered because the server may still have applied it.
+		c.removeSubscription(dataType)
+	}
+	return err
+}
+
+// Unsubscribe is UnsubscribeContext bounded by the ack timeout.
+func (c *WebSocketClient) Unsubscribe(id, dataType string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.getAckTimeout())
+	defer cancel()
+	return c.UnsubscribeContext(ctx, id, dataType)
+}
+
+// UnsubscribeContext cancels dataType and removes it from the registry. It
+// waits for the acknowledgement like SubscribeContext.
+func (c *WebSocketClient) UnsubscribeContext(ctx context.Context, id, dataType string) error {
+	return c.unsubscribe(ctx, id, dataType, true)
+}
+
+// UnsubscribeAsync is Unsubscribe without waiting for the acknowledgement,
+// for use in message callbacks.
+func (c *WebSocketClient) UnsubscribeAsync(id, dataType string) error {
+	return c.unsubscribe(context.Background(), id, dataType, false)
+}
+
+func (c *WebSocketClient) unsubscribe(ctx context.Context, id, dataType string, wait bool) error {
+	c.mu.Lock()
+	c.removeSubscription(dataType)
+	c.mu.Unlock()
+
+	err := c.request(ctx, "unsub", id, dataType, wait)
+	if _, rejected := err.(*SubscriptionError); !rejected && c.isSupervised() {
+		return nil
+	}
+	return err
+}
+
+// request sends a sub or unsub frame and, if wait is set, waits for its ack
+// when a reader is running.
+func (c *WebSocketClient) request(ctx context.Context, reqType, id, dataType string, wait bool) error {
+	var result chan ack
+	c.mu.Lock()
+	if wait && c.reading {
+		result = make(chan ack, 1)
+		if c.pendingAcks == nil {
+			c.pendingAcks = make(map[string]pendingAck)
+		}
+		c.pendingAcks[id] = pendingAck{dataType: dataType, result: result}
+	}
+	c.mu.Unlock()
+
+	err := c.Send(map[string]interface{}{
+		"id":       id,
+		"reqType":  reqType,
+		"dataType": dataType,
+	})
+	if err != nil || result == nil {
+		if result != nil {
+			c.forgetAck(id, result)
+		}
+		return err
+	}
+
+	select {
+	case answer := <-result:
+		if answer.err != nil {
+			return answer.err
+		}
+		if answer.code != "0" {
+			return &SubscriptionError{ID: id, DataType: dataType, Code: answer.code, Msg: answer.msg}
+		}
+		return nil
+	case <-ctx.Done():
+		c.forgetAck(id, result)
+		return fmt.Errorf("no acknowledgement for %s request %s (%s): %w", reqType, id, dataType, ctx.Err())
+	}
+}
+
+// handleAck consumes an acknowledgement frame, which carries the request id
+// and a code but no data, and reports whether msg was one.
+func (c *WebSocketClient) handleAck(msg map[string]interface{}) bool {
+	id, ok := msg["id"].(string)
+	if !ok || msg["data"] != nil {
+		return false
+	}
+	rawCode, ok := msg["code"]
+	if !ok {
+		return false
+	}
+
+	var code string
+	switch v := rawCode.(type) {
+	case float64:
+		code = strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		code = v
+	default:
+		code = fmt.Sprint(v)
+	}
+	text, _ := msg["msg"].(string)
+
+	c.mu.Lock()
+	pending, waiting := c.pendingAcks[id]
+	delete(c.pendingAcks, id)
+	for i := range c.subscriptions {
+		if c.subscriptions[i].ID != id {
+			continue
+		}
+		if code == "0" {
+			c.subscriptions[i].Acknowledged = true
+		} else {
+			c.subscriptions = append(c.subscriptions[:i], c.subscriptions[i+1:]...)
+		}
+		break
+	}
+	c.mu.Unlock()
+
+	if waiting {
+		pending.result <- ack{code: code, msg: text}
+	}
+	return true
+}
+
+// failPendingAcks wakes every request waiting for an ack with err, and
+// marks the registry unacknowledged because the connection is gone.
+func (c *WebSocketClient) failPendingAcks(err error) {
+	c.mu.Lock()
+	pending := c.pendingAcks
+	c.pendingAcks = nil
+	for i := range c.subscriptions {
+		c.subscriptions[i].Acknowledged = false
+	}
+	c.mu.Unlock()
+
+	if err == nil {
+		err = fmt.Errorf("WebSocket client disconnected")
+	}
+	for id, p := range pending {
+		p.result <- ack{err: fmt.Errorf("connection ended before %s request %s was acknowledged: %w", p.dataType, id, err)}
+	}
+}
+
+func (c *WebSocketClient) forgetAck(id string, result chan ack) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if p, ok := c.pendingAcks[id]; ok && p.result == result {
+		delete(c.pendingAcks, id)
+	}
+}
+
+// removeSubscription drops dataType from the registry. c.mu must be held.
+func (c *WebSocketClient) removeSubscription(dataType string) {
+	for i, sub := range c.subscriptions {
+		if sub.DataType == dataType {
+			c.subscriptions = append(c.subscriptions[:i], c.subscriptions[i+1:]...)
+			return
+		}
+	}
+}
+
+func (c *WebSocketClient) getAckTimeout() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.ackTimeout <= 0 {
+		return DefaultAckTimeout
+	}
+	return c.ackTimeout
+}
+
+func (c *WebSocketClient) isSupervised() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.supervisorStop != nil
+}
@@ -0,0 +1,220 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	bxerrors "github.com/tigusigalpa/bingx-go/v2/errors"
+)
+
+// newAckServer sends greeting on every connection, then answers every
+// request frame with answer(frame), or not at all when answer returns an
+// empty string.
+func newAckServer(t *testing.T, answer func(frame map[string]interface{}) string, greeting ...string) *httptest.Server {
+	t.Helper()
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		for _, frame := range greeting {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(frame)); err != nil {
+				return
+			}
+		}
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var frame map[string]interface{}
+			_ = json.Unmarshal(data, &frame)
+			if reply := answer(frame); reply != "" {
+				if err := conn.WriteMessage(websocket.TextMessage, []byte(reply)); err != nil {
+					return
+				}
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func ackFrame(frame map[string]interface{}, code int, msg string) string {
+	return fmt.Sprintf(`{"id":%q,"code":%d,"msg":%q,"dataType":"","data":null}`, frame["id"], code, msg)
+}
+
+// listening connects to srv and runs Listen until the test ends. callbacks
+// are registered before the first frame is read.
+func listening(t *testing.T, srv *httptest.Server, callbacks ...MessageCallback) *MarketDataStream {
+	t.Helper()
+	stream := &MarketDataStream{WebSocketClient: NewWebSocketClient("ws" + strings.TrimPrefix(srv.URL, "http"))}
+	for _, callback := range callbacks {
+		stream.OnMessage(callback)
+	}
+	if err := stream.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = stream.Listen()
+	}()
+	t.Cleanup(func() {
+		_ = stream.Disconnect()
+		<-done
+	})
+	waitFor(t, func() bool {
+		stream.mu.RLock()
+		defer stream.mu.RUnlock()
+		return stream.reading
+	})
+	return stream
+}
+
+func TestSubscribe_WaitsForAck(t *testing.T) {
+	srv := newAckServer(t, func(frame map[string]interface{}) string {
+		time.Sleep(20 * time.Millisecond)
+		return ackFrame(frame, 0, "")
+	})
+	stream := listening(t, srv)
+
+	var delivered atomic.Int32
+	stream.OnMessage(func(data map[string]interface{}) { delivered.Add(1) })
+
+	if err := stream.SubscribeTrade("BTC-USDT", "sub-1"); err != nil {
+		t.Fatalf("SubscribeTrade() = %v", err)
+	}
+	want := []Subscription{{ID: "sub-1", DataType: "BTC-USDT@trade", Acknowledged: true}}
+	if got := stream.Subscriptions(); len(got) != 1 || got[0] != want[0] {
+		t.Errorf("Subscriptions() = %+v, want %+v", got, want)
+	}
+
+	if err := stream.UnsubscribeTrade("BTC-USDT", "unsub-1"); err != nil {
+		t.Fatalf("UnsubscribeTrade() = %v", err)
+	}
+	if got := stream.Subscriptions(); len(got) != 0 {
+		t.Errorf("Subscriptions() after unsubscribe = %+v, want none", got)
+	}
+	if n := delivered.Load(); n != 0 {
+		t.Errorf("acks reached %d message callbacks", n)
+	}
+}
+
+func TestSubscribe_Rejected(t *testing.T) {
+	srv := newAckServer(t, func(frame map[string]interface{}) string {
+		return ackFrame(frame, 100400, "invalid dataType")
+	})
+	stream := listening(t, srv)
+
+	err := stream.SubscribeDepth("NOPE-USDT", 20, "sub-1")
+	var subErr *SubscriptionError
+	if !errors.As(err, &subErr) {
+		t.Fatalf("SubscribeDepth() = %v, want *SubscriptionError", err)
+	}
+	if subErr.ID != "sub-1" || subErr.DataType != "NOPE-USDT@depth20" || subErr.Code != "100400" || subErr.Msg != "invalid dataType" {
+		t.Errorf("error = %+v", subErr)
+	}
+	if !errors.Is(err, bxerrors.ErrInvalidParameter) {
+		t.Errorf("errors.Is(%v, ErrInvalidParameter) = false", err)
+	}
+	if got := stream.Subscriptions(); len(got) != 0 {
+		t.Errorf("rejected subscription is registered: %+v", got)
+	}
+}
+
+func TestSubscribe_RejectedWhileCallbackBusy(t *testing.T) {
+	srv := newAckServer(t, func(frame map[string]interface{}) string {
+		return ackFrame(frame, 80015, "dataType not supported")
+	}, `{"code":0,"dataType":"BTC-USDT@trade","data":[]}`)
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	stream := listening(t, srv, func(data map[string]interface{}) {
+		close(entered)
+		<-release
+	})
+
+	<-entered
+	result := make(chan error, 1)
+	go func() { result <- stream.SubscribeTicker("NOPE-USDT", "sub-1") }()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+
+	var subErr *SubscriptionError
+	if err := <-result; !errors.As(err, &subErr) || subErr.Code != "80015" {
+		t.Fatalf("SubscribeTicker() = %v, want the 80015 rejection", err)
+	}
+}
+
+func TestSubscribeAsync_FromCallback(t *testing.T) {
+	srv := newAckServer(t, func(frame map[string]interface{}) string {
+		return ackFrame(frame, 0, "")
+	}, `{"code":0,"dataType":"BTC-USDT@trade","data":[]}`)
+	result := make(chan error, 1)
+	started := make(chan struct{})
+	var stream *MarketDataStream
+	stream = listening(t, srv, func(data map[string]interface{}) {
+		<-started
+		result <- stream.SubscribeAsync("sub-1", "ETH-USDT@trade")
+	})
+	close(started)
+	if err := <-result; err != nil {
+		t.Fatalf("SubscribeAsync() = %v", err)
+	}
+	waitFor(t, func() bool {
+		subs := stream.Subscriptions()
+		return len(subs) == 1 && subs[0].Acknowledged
+	})
+}
+
+func TestSubscribe_AckTimeout(t *testing.T) {
+	srv := newAckServer(t, func(frame map[string]interface{}) string { return "" })
+	stream := listening(t, srv)
+	stream.SetAckTimeout(20 * time.Millisecond)
+
+	err := stream.SubscribeTicker("BTC-USDT", "sub-1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("SubscribeTicker() = %v, want deadline exceeded", err)
+	}
+	// The server may still apply the request, so it stays registered.
+	if got := stream.Subscriptions(); len(got) != 1 || got[0].Acknowledged {
+		t.Errorf("Subscriptions() = %+v, want one unacknowledged entry", got)
+	}
+}
+
+func TestSubscribe_BeforeListen(t *testing.T) {
+	srv := newAckServer(t, func(frame map[string]interface{}) string {
+		return ackFrame(frame, 0, "")
+	})
+	stream := NewMarketDataStream()
+	stream.WebSocketClient = NewWebSocketClient("ws" + strings.TrimPrefix(srv.URL, "http"))
+	if err := stream.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = stream.Disconnect() }()
+
+	// Nobody reads the ack yet, so Subscribe must not wait for it.
+	if err := stream.SubscribeKline("BTC-USDT", "1m"); err != nil {
+		t.Fatalf("SubscribeKline() = %v", err)
+	}
+	go func() { _ = stream.Listen() }()
+
+	waitFor(t, func() bool {
+		subs := stream.Subscriptions()
+		return len(subs) == 1 && subs[0].Acknowledged
+	})
+}